@@ -17,7 +17,6 @@ limitations under the License.
 // Package app implements a server that runs a set of active
 // components.  This includes replication controllers, service endpoints and
 // nodes.
-//
 package app
 
 import (
@@ -53,8 +52,13 @@ func startStatefulSetController(ctx ControllerContext) (http.Handler, bool, erro
 		ctx.InformerFactory.Core().V1().Pods(),
 		ctx.InformerFactory.Apps().V1().StatefulSets(),
 		ctx.InformerFactory.Core().V1().PersistentVolumeClaims(),
+		ctx.InformerFactory.Core().V1().PersistentVolumes(),
 		ctx.InformerFactory.Apps().V1().ControllerRevisions(),
 		ctx.ClientBuilder.ClientOrDie("statefulset-controller"),
+		statefulset.WithPodFailureBackoff(
+			ctx.ComponentConfig.StatefulSetController.PodFailureBackoffInitialDuration.Duration,
+			ctx.ComponentConfig.StatefulSetController.PodFailureBackoffMaxDuration.Duration,
+		),
 	).Run(int(ctx.ComponentConfig.StatefulSetController.ConcurrentStatefulSetSyncs), ctx.Stop)
 	return nil, true, nil
 }
@@ -262,7 +262,9 @@ func TestAddFlags(t *testing.T) {
 		},
 		StatefulSetController: &StatefulSetControllerOptions{
 			&statefulsetconfig.StatefulSetControllerConfiguration{
-				ConcurrentStatefulSetSyncs: 15,
+				ConcurrentStatefulSetSyncs:       15,
+				PodFailureBackoffInitialDuration: metav1.Duration{Duration: 1 * time.Second},
+				PodFailureBackoffMaxDuration:     metav1.Duration{Duration: 1 * time.Minute},
 			},
 		},
 		DeprecatedFlags: &DeprecatedControllerOptions{
@@ -528,7 +530,9 @@ func TestApplyTo(t *testing.T) {
 				DeploymentControllerSyncPeriod: metav1.Duration{Duration: 45 * time.Second},
 			},
 			StatefulSetController: statefulsetconfig.StatefulSetControllerConfiguration{
-				ConcurrentStatefulSetSyncs: 15,
+				ConcurrentStatefulSetSyncs:       15,
+				PodFailureBackoffInitialDuration: metav1.Duration{Duration: 1 * time.Second},
+				PodFailureBackoffMaxDuration:     metav1.Duration{Duration: 1 * time.Minute},
 			},
 			DeprecatedController: kubectrlmgrconfig.DeprecatedControllerConfiguration{
 				DeletingPodsQPS:    0.1,
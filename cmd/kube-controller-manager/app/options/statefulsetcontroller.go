@@ -36,6 +36,8 @@ func (o *StatefulSetControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	}
 
 	fs.Int32Var(&o.ConcurrentStatefulSetSyncs, "concurrent-statefulset-syncs", o.ConcurrentStatefulSetSyncs, "The number of statefulset objects that are allowed to sync concurrently. Larger number = more responsive statefulsets, but more CPU (and network) load")
+	fs.DurationVar(&o.PodFailureBackoffInitialDuration.Duration, "statefulset-pod-failure-backoff-initial-duration", o.PodFailureBackoffInitialDuration.Duration, "The initial backoff the statefulset controller waits before recreating a Pod whose ordinal keeps failing.")
+	fs.DurationVar(&o.PodFailureBackoffMaxDuration.Duration, "statefulset-pod-failure-backoff-max-duration", o.PodFailureBackoffMaxDuration.Duration, "The maximum backoff the statefulset controller waits before recreating a Pod whose ordinal keeps failing.")
 }
 
 // ApplyTo fills up StatefulSetController config with options.
@@ -45,6 +47,8 @@ func (o *StatefulSetControllerOptions) ApplyTo(cfg *statefulsetconfig.StatefulSe
 	}
 
 	cfg.ConcurrentStatefulSetSyncs = o.ConcurrentStatefulSetSyncs
+	cfg.PodFailureBackoffInitialDuration = o.PodFailureBackoffInitialDuration
+	cfg.PodFailureBackoffMaxDuration = o.PodFailureBackoffMaxDuration
 
 	return nil
 }
@@ -59,5 +63,11 @@ func (o *StatefulSetControllerOptions) Validate() []error {
 	if o.ConcurrentStatefulSetSyncs < 1 {
 		errs = append(errs, fmt.Errorf("concurrent-statefulset-syncs must be greater than 0, but got %d", o.ConcurrentStatefulSetSyncs))
 	}
+	if o.PodFailureBackoffInitialDuration.Duration < 0 {
+		errs = append(errs, fmt.Errorf("statefulset-pod-failure-backoff-initial-duration must not be negative, but got %s", o.PodFailureBackoffInitialDuration.Duration))
+	}
+	if o.PodFailureBackoffMaxDuration.Duration < o.PodFailureBackoffInitialDuration.Duration {
+		errs = append(errs, fmt.Errorf("statefulset-pod-failure-backoff-max-duration must not be less than statefulset-pod-failure-backoff-initial-duration, but got %s < %s", o.PodFailureBackoffMaxDuration.Duration, o.PodFailureBackoffInitialDuration.Duration))
+	}
 	return errs
 }
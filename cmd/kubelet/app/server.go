@@ -395,6 +395,9 @@ func UnsecuredDependencies(s *options.KubeletServer, featureGate featuregate.Fea
 	mounter := mount.New(s.ExperimentalMounterPath)
 	subpather := subpath.New(mounter)
 	hu := hostutil.NewHostUtil()
+	if s.ExperimentalHostUtilRootPath != "" {
+		hu = hostutil.NewContainerizedHostUtil(s.ExperimentalHostUtilRootPath)
+	}
 	var pluginRunner = exec.New()
 
 	var dockerOptions *kubelet.DockerOptions
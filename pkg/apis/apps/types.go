@@ -27,8 +27,9 @@ import (
 
 // StatefulSet represents a set of pods with consistent identities.
 // Identities are defined as:
-//  - Network: A single stable DNS and hostname.
-//  - Storage: As many VolumeClaims as requested.
+//   - Network: A single stable DNS and hostname.
+//   - Storage: As many VolumeClaims as requested.
+//
 // The StatefulSet guarantees that a given network identity will always
 // map to the same storage identity.
 type StatefulSet struct {
@@ -254,7 +255,13 @@ type StatefulSetStatus struct {
 // StatefulSetConditionType describes the condition types of StatefulSets.
 type StatefulSetConditionType string
 
-// TODO: Add valid condition types for Statefulsets.
+// These are valid conditions of a statefulset.
+const (
+	// StatefulSetConditionPodBackoff indicates that the StatefulSet
+	// controller is backing off recreating one or more Pods whose ordinal
+	// keeps failing, instead of recreating them on every sync.
+	StatefulSetConditionPodBackoff StatefulSetConditionType = "PodBackoff"
+)
 
 // StatefulSetCondition describes the state of a statefulset at a certain point.
 type StatefulSetCondition struct {
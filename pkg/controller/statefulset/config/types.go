@@ -16,10 +16,24 @@ limitations under the License.
 
 package config
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // StatefulSetControllerConfiguration contains elements describing StatefulSetController.
 type StatefulSetControllerConfiguration struct {
 	// concurrentStatefulSetSyncs is the number of statefulset objects that are
 	// allowed to sync concurrently. Larger number = more responsive statefulsets,
 	// but more CPU (and network) load.
 	ConcurrentStatefulSetSyncs int32
+
+	// podFailureBackoffInitialDuration and podFailureBackoffMaxDuration bound
+	// the exponential backoff the controller applies, per StatefulSet
+	// ordinal, before recreating a Pod that keeps failing. Slot-aware
+	// workloads (for example databases that need time to fail over before
+	// accepting a replacement) can raise these to avoid tight create/delete
+	// loops. Zero uses the controller's built-in defaults (1s initial, 1m
+	// max).
+	PodFailureBackoffInitialDuration metav1.Duration
+	PodFailureBackoffMaxDuration     metav1.Duration
 }
@@ -17,9 +17,21 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubectrlmgrconfigv1alpha1 "k8s.io/kube-controller-manager/config/v1alpha1"
 )
 
+// DefaultPodFailureBackoffInitialDuration and
+// DefaultPodFailureBackoffMaxDuration are the backoff bounds applied when a
+// StatefulSetControllerConfiguration does not set its own, matching the
+// controller's built-in defaults from before these knobs existed.
+const (
+	DefaultPodFailureBackoffInitialDuration = 1 * time.Second
+	DefaultPodFailureBackoffMaxDuration     = 1 * time.Minute
+)
+
 // RecommendedDefaultStatefulSetControllerConfiguration defaults a pointer to a
 // StatefulSetControllerConfiguration struct. This will set the recommended default
 // values, but they may be subject to change between API versions. This function
@@ -33,4 +45,10 @@ func RecommendedDefaultStatefulSetControllerConfiguration(obj *kubectrlmgrconfig
 	if obj.ConcurrentStatefulSetSyncs == 0 {
 		obj.ConcurrentStatefulSetSyncs = 5
 	}
+	if obj.PodFailureBackoffInitialDuration.Duration == 0 {
+		obj.PodFailureBackoffInitialDuration = metav1.Duration{Duration: DefaultPodFailureBackoffInitialDuration}
+	}
+	if obj.PodFailureBackoffMaxDuration.Duration == 0 {
+		obj.PodFailureBackoffMaxDuration = metav1.Duration{Duration: DefaultPodFailureBackoffMaxDuration}
+	}
 }
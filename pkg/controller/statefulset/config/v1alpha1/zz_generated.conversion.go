@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -82,10 +83,14 @@ func Convert_v1_GroupResource_To_v1alpha1_GroupResource(in *v1.GroupResource, ou
 
 func autoConvert_v1alpha1_StatefulSetControllerConfiguration_To_config_StatefulSetControllerConfiguration(in *v1alpha1.StatefulSetControllerConfiguration, out *config.StatefulSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentStatefulSetSyncs = in.ConcurrentStatefulSetSyncs
+	out.PodFailureBackoffInitialDuration = in.PodFailureBackoffInitialDuration
+	out.PodFailureBackoffMaxDuration = in.PodFailureBackoffMaxDuration
 	return nil
 }
 
 func autoConvert_config_StatefulSetControllerConfiguration_To_v1alpha1_StatefulSetControllerConfiguration(in *config.StatefulSetControllerConfiguration, out *v1alpha1.StatefulSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentStatefulSetSyncs = in.ConcurrentStatefulSetSyncs
+	out.PodFailureBackoffInitialDuration = in.PodFailureBackoffInitialDuration
+	out.PodFailureBackoffMaxDuration = in.PodFailureBackoffMaxDuration
 	return nil
 }
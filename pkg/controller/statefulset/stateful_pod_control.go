@@ -27,11 +27,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	clientset "k8s.io/client-go/kubernetes"
 	appslisters "k8s.io/client-go/listers/apps/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/kubernetes/pkg/features"
 )
 
 // StatefulPodControlInterface defines the interface that StatefulSetController uses to create, update, and delete Pods,
@@ -57,9 +59,10 @@ func NewRealStatefulPodControl(
 	setLister appslisters.StatefulSetLister,
 	podLister corelisters.PodLister,
 	pvcLister corelisters.PersistentVolumeClaimLister,
+	pvLister corelisters.PersistentVolumeLister,
 	recorder record.EventRecorder,
 ) StatefulPodControlInterface {
-	return &realStatefulPodControl{client, setLister, podLister, pvcLister, recorder}
+	return &realStatefulPodControl{client, setLister, podLister, pvcLister, pvLister, recorder}
 }
 
 // realStatefulPodControl implements StatefulPodControlInterface using a clientset.Interface to communicate with the
@@ -69,6 +72,7 @@ type realStatefulPodControl struct {
 	setLister appslisters.StatefulSetLister
 	podLister corelisters.PodLister
 	pvcLister corelisters.PersistentVolumeClaimLister
+	pvLister  corelisters.PersistentVolumeLister
 	recorder  record.EventRecorder
 }
 
@@ -85,9 +89,84 @@ func (spc *realStatefulPodControl) CreateStatefulPod(set *apps.StatefulSet, pod
 		return err
 	}
 	spc.recordPodEvent("create", set, pod, err)
+	if err == nil {
+		spc.hintNodeFromBoundLocalPVs(set, pod)
+	}
 	return err
 }
 
+// hintNodeFromBoundLocalPVs sets pod's NominatedNodeName to the node implied
+// by a bound PVC's local PV, if any of pod's PVCs are already Bound to a PV
+// whose NodeAffinity pins it to exactly one node. This is the case when the
+// replacement for a deleted or failed pod is created while its PVCs survive:
+// the new pod can only ever run on that node, so nominating it lets the
+// scheduler try it first (see the PreferNominatedNode feature) instead of
+// evaluating every node in the cluster.
+//
+// Gated by StatefulSetLocalPVNodeHint: a wrong hint only costs one extra
+// failed Filter call before the scheduler falls back to a full node search,
+// but it's still a behavior change operators should be able to opt into.
+func (spc *realStatefulPodControl) hintNodeFromBoundLocalPVs(set *apps.StatefulSet, pod *v1.Pod) {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.StatefulSetLocalPVNodeHint) {
+		return
+	}
+	nodeName, ok := spc.boundLocalPVNodeName(set, pod)
+	if !ok {
+		return
+	}
+	podCopy := pod.DeepCopy()
+	podCopy.Status.NominatedNodeName = nodeName
+	if _, err := spc.client.CoreV1().Pods(set.Namespace).UpdateStatus(context.TODO(), podCopy, metav1.UpdateOptions{}); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to set node hint on Pod %s: %v", pod.Name, err))
+		return
+	}
+	pod.Status.NominatedNodeName = nodeName
+}
+
+// boundLocalPVNodeName returns the node implied by the first of pod's PVCs
+// that is already Bound to a PV whose NodeAffinity pins it to exactly one
+// node, along with true. It returns false if no such PVC exists, or if
+// spc has no pvLister to consult (e.g. in tests that don't exercise it).
+func (spc *realStatefulPodControl) boundLocalPVNodeName(set *apps.StatefulSet, pod *v1.Pod) (string, bool) {
+	if spc.pvLister == nil {
+		return "", false
+	}
+	for _, claim := range getPersistentVolumeClaims(set, pod) {
+		pvc, err := spc.pvcLister.PersistentVolumeClaims(claim.Namespace).Get(claim.Name)
+		if err != nil || pvc.Status.Phase != v1.ClaimBound || pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv, err := spc.pvLister.Get(pvc.Spec.VolumeName)
+		if err != nil {
+			continue
+		}
+		if nodeName, ok := nodeNameFromPVNodeAffinity(pv); ok {
+			return nodeName, true
+		}
+	}
+	return "", false
+}
+
+// nodeNameFromPVNodeAffinity returns the node name implied by pv's
+// NodeAffinity, if it pins the volume to exactly one node via a single
+// kubernetes.io/hostname In match, the pattern used by local PVs (e.g. ones
+// provisioned by the local volume static provisioner).
+func nodeNameFromPVNodeAffinity(pv *v1.PersistentVolume) (string, bool) {
+	affinity := pv.Spec.NodeAffinity
+	if affinity == nil || affinity.Required == nil || len(affinity.Required.NodeSelectorTerms) != 1 {
+		return "", false
+	}
+	term := affinity.Required.NodeSelectorTerms[0]
+	if len(term.MatchExpressions) != 1 || len(term.MatchFields) != 0 {
+		return "", false
+	}
+	expr := term.MatchExpressions[0]
+	if expr.Key != v1.LabelHostname || expr.Operator != v1.NodeSelectorOpIn || len(expr.Values) != 1 {
+		return "", false
+	}
+	return expr.Values[0], true
+}
+
 func (spc *realStatefulPodControl) UpdateStatefulPod(set *apps.StatefulSet, pod *v1.Pod) error {
 	attemptedUpdate := false
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
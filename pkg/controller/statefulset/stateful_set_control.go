@@ -17,7 +17,10 @@ limitations under the License.
 package statefulset
 
 import (
+	"fmt"
 	"sort"
+	"sync"
+	"time"
 
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -25,11 +28,25 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/controller/history"
 	"k8s.io/kubernetes/pkg/features"
 )
 
+const (
+	// PersistentFailedPodReason is the event reason recorded when a Pod's
+	// ordinal has failed and been recreated enough times in a row that it
+	// looks like a crash loop rather than a transient failure.
+	PersistentFailedPodReason = "PersistentFailedPod"
+
+	// maxFailuresBeforeWarning is the number of consecutive recreations of
+	// the same ordinal after which the controller warns that the slot may
+	// need operator attention (e.g. removing it from the set) instead of
+	// being retried forever at the same cadence.
+	maxFailuresBeforeWarning = 5
+)
+
 // StatefulSetControl implements the control logic for updating StatefulSets and their children Pods. It is implemented
 // as an interface to allow for extensions that provide different semantics. Currently, there is only one implementation.
 type StatefulSetControlInterface interface {
@@ -47,6 +64,23 @@ type StatefulSetControlInterface interface {
 	AdoptOrphanRevisions(set *apps.StatefulSet, revisions []*apps.ControllerRevision) error
 }
 
+// StatefulSetControlOption configures a defaultStatefulSetControl built by
+// NewDefaultStatefulSetControl.
+type StatefulSetControlOption func(*defaultStatefulSetControl)
+
+// WithPodFailureBackoff overrides the default exponential backoff (1s
+// initial, 1m max) applied when a StatefulSet ordinal keeps failing and
+// being recreated. Slot-aware workloads that need more time to fail over
+// before accepting a replacement can use this to avoid tight create/delete
+// loops; see StatefulSetControllerConfiguration.PodFailureBackoffInitialDuration
+// and PodFailureBackoffMaxDuration for the controller-wide config knobs that
+// set this.
+func WithPodFailureBackoff(initial, max time.Duration) StatefulSetControlOption {
+	return func(ssc *defaultStatefulSetControl) {
+		ssc.failedPodsBackoff = flowcontrol.NewBackOff(initial, max)
+	}
+}
+
 // NewDefaultStatefulSetControl returns a new instance of the default implementation StatefulSetControlInterface that
 // implements the documented semantics for StatefulSets. podControl is the PodControlInterface used to create, update,
 // and delete Pods and to create PersistentVolumeClaims. statusUpdater is the StatefulSetStatusUpdaterInterface used
@@ -56,8 +90,20 @@ func NewDefaultStatefulSetControl(
 	podControl StatefulPodControlInterface,
 	statusUpdater StatefulSetStatusUpdaterInterface,
 	controllerHistory history.Interface,
-	recorder record.EventRecorder) StatefulSetControlInterface {
-	return &defaultStatefulSetControl{podControl, statusUpdater, controllerHistory, recorder}
+	recorder record.EventRecorder,
+	opts ...StatefulSetControlOption) StatefulSetControlInterface {
+	ssc := &defaultStatefulSetControl{
+		podControl:        podControl,
+		statusUpdater:     statusUpdater,
+		controllerHistory: controllerHistory,
+		recorder:          recorder,
+		failedPodsBackoff: flowcontrol.NewBackOff(1*time.Second, 1*time.Minute),
+		failureCounts:     map[string]int32{},
+	}
+	for _, opt := range opts {
+		opt(ssc)
+	}
+	return ssc
 }
 
 type defaultStatefulSetControl struct {
@@ -65,6 +111,15 @@ type defaultStatefulSetControl struct {
 	statusUpdater     StatefulSetStatusUpdaterInterface
 	controllerHistory history.Interface
 	recorder          record.EventRecorder
+	// failedPodsBackoff tracks, per ordinal, how long to wait before
+	// recreating a Pod that keeps failing so that one crash-looping slot
+	// does not starve progress on the rest of the set.
+	failedPodsBackoff *flowcontrol.Backoff
+	// failureCounts tracks, per ordinal, the number of consecutive
+	// recreations so a warning event can be raised once a slot looks
+	// persistently broken rather than merely flaky.
+	failureCounts      map[string]int32
+	failureCountsMutex sync.Mutex
 }
 
 // UpdateStatefulSet executes the core logic loop for a stateful set, applying the predictable and
@@ -380,6 +435,12 @@ func (ssc *defaultStatefulSetControl) updateStatefulSet(
 			firstUnhealthyPod.Name)
 	}
 
+	// Surface whether any ordinal is currently in failedPodsBackoff as a
+	// status condition, independent of the control flow below (which may
+	// return early for unrelated reasons, e.g. waiting on a Pod to
+	// terminate, before ever reaching the ordinal in backoff).
+	ssc.updatePodBackoffCondition(&status, set, replicas, condemned)
+
 	// If the StatefulSet is being deleted, don't do anything other than updating
 	// status.
 	if set.DeletionTimestamp != nil {
@@ -392,6 +453,21 @@ func (ssc *defaultStatefulSetControl) updateStatefulSet(
 	for i := range replicas {
 		// delete and recreate failed pods
 		if isFailed(replicas[i]) {
+			backoffKey := statefulPodBackoffKey(set, i)
+			now := ssc.failedPodsBackoff.Clock.Now()
+			if ssc.failedPodsBackoff.IsInBackOffSinceUpdate(backoffKey, now) {
+				delay := ssc.failedPodsBackoff.Get(backoffKey)
+				klog.V(4).Infof("StatefulSet %s/%s is backing off recreating failed Pod %s, %v remaining",
+					set.Namespace, set.Name, replicas[i].Name, delay)
+				// Only OrderedReady StatefulSets must stop at the first
+				// blocked ordinal; under Parallel, one ordinal backing off
+				// its recreation must not prevent progress on the rest,
+				// same as every other blocking condition below.
+				if monotonic {
+					return &status, nil
+				}
+				continue
+			}
 			ssc.recorder.Eventf(set, v1.EventTypeWarning, "RecreatingFailedPod",
 				"StatefulSet %s/%s is recreating failed Pod %s",
 				set.Namespace,
@@ -400,6 +476,16 @@ func (ssc *defaultStatefulSetControl) updateStatefulSet(
 			if err := ssc.podControl.DeleteStatefulPod(set, replicas[i]); err != nil {
 				return &status, err
 			}
+			// Only count this towards the ordinal's backoff once the delete
+			// actually went through; a failed delete attempt (e.g. API
+			// server hiccup) shouldn't punish the next, possibly successful,
+			// recreation.
+			ssc.failedPodsBackoff.Next(backoffKey, now)
+			if failures := ssc.recordOrdinalFailure(backoffKey); failures >= maxFailuresBeforeWarning {
+				ssc.recorder.Eventf(set, v1.EventTypeWarning, PersistentFailedPodReason,
+					"Pod %s (ordinal %d) has failed and been recreated %d times in a row; it may need to be removed from the set",
+					replicas[i].Name, i, failures)
+			}
 			if getPodRevision(replicas[i]) == currentRevision.Name {
 				status.CurrentReplicas--
 			}
@@ -468,6 +554,12 @@ func (ssc *defaultStatefulSetControl) updateStatefulSet(
 				replicas[i].Name)
 			return &status, nil
 		}
+		// Once the Pod is running and ready (or available), any prior failure
+		// streak for this ordinal is no longer relevant.
+		if isRunningAndReady(replicas[i]) {
+			ssc.resetOrdinalFailure(statefulPodBackoffKey(set, i))
+		}
+
 		// Enforce the StatefulSet invariants
 		if identityMatches(set, replicas[i]) && storageMatches(set, replicas[i]) {
 			continue
@@ -598,4 +690,98 @@ func (ssc *defaultStatefulSetControl) updateStatefulSetStatus(
 	return nil
 }
 
+// statefulPodBackoffKey returns the per-ordinal key used to track failure
+// backoff and counts. It is built from the StatefulSet's UID and the ordinal,
+// so it is kept across syncs and generations of the same StatefulSet object.
+func statefulPodBackoffKey(set *apps.StatefulSet, ordinal int) string {
+	return fmt.Sprintf("%s/%d", set.UID, ordinal)
+}
+
+// recordOrdinalFailure increments and returns the number of consecutive
+// recreations recorded for the given ordinal key.
+func (ssc *defaultStatefulSetControl) recordOrdinalFailure(key string) int32 {
+	ssc.failureCountsMutex.Lock()
+	defer ssc.failureCountsMutex.Unlock()
+	ssc.failureCounts[key]++
+	return ssc.failureCounts[key]
+}
+
+// resetOrdinalFailure clears any recorded failure streak for the given
+// ordinal key once its Pod is healthy again.
+func (ssc *defaultStatefulSetControl) resetOrdinalFailure(key string) {
+	ssc.failedPodsBackoff.Reset(key)
+	ssc.failureCountsMutex.Lock()
+	defer ssc.failureCountsMutex.Unlock()
+	delete(ssc.failureCounts, key)
+}
+
+// updatePodBackoffCondition sets StatefulSetConditionPodBackoff to True on
+// status if any failed Pod across podGroups is currently in
+// failedPodsBackoff, and clears it otherwise.
+func (ssc *defaultStatefulSetControl) updatePodBackoffCondition(status *apps.StatefulSetStatus, set *apps.StatefulSet, podGroups ...[]*v1.Pod) {
+	now := ssc.failedPodsBackoff.Clock.Now()
+	for _, pods := range podGroups {
+		for _, pod := range pods {
+			if !isFailed(pod) {
+				continue
+			}
+			ordinal := getOrdinal(pod)
+			if !ssc.failedPodsBackoff.IsInBackOffSinceUpdate(statefulPodBackoffKey(set, ordinal), now) {
+				continue
+			}
+			setStatefulSetCondition(status, apps.StatefulSetCondition{
+				Type:    apps.StatefulSetConditionPodBackoff,
+				Status:  v1.ConditionTrue,
+				Reason:  "PodCreateBackoff",
+				Message: fmt.Sprintf("Pod %s (ordinal %d) is in backoff before being recreated", pod.Name, ordinal),
+			})
+			return
+		}
+	}
+	removeStatefulSetCondition(status, apps.StatefulSetConditionPodBackoff)
+}
+
+// getStatefulSetCondition returns the condition with the provided type, or
+// nil if status has none.
+func getStatefulSetCondition(status apps.StatefulSetStatus, condType apps.StatefulSetConditionType) *apps.StatefulSetCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// setStatefulSetCondition updates status to include condition. If a
+// condition of the same type already exists with the same status, only its
+// Reason and Message are refreshed and LastTransitionTime is left alone.
+func setStatefulSetCondition(status *apps.StatefulSetStatus, condition apps.StatefulSetCondition) {
+	currentCond := getStatefulSetCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	} else {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	status.Conditions = append(filterOutStatefulSetCondition(status.Conditions, condition.Type), condition)
+}
+
+// removeStatefulSetCondition removes the condition with the provided type,
+// if any, from status.
+func removeStatefulSetCondition(status *apps.StatefulSetStatus, condType apps.StatefulSetConditionType) {
+	status.Conditions = filterOutStatefulSetCondition(status.Conditions, condType)
+}
+
+// filterOutStatefulSetCondition returns a new slice of conditions excluding
+// any of the provided type.
+func filterOutStatefulSetCondition(conditions []apps.StatefulSetCondition, condType apps.StatefulSetConditionType) []apps.StatefulSetCondition {
+	var newConditions []apps.StatefulSetCondition
+	for _, c := range conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	return newConditions
+}
+
 var _ StatefulSetControlInterface = &defaultStatefulSetControl{}
@@ -506,7 +506,7 @@ func TestStatefulSetControl_getSetRevisions(t *testing.T) {
 		spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1().StatefulSets(), informerFactory.Apps().V1().ControllerRevisions())
 		ssu := newFakeStatefulSetStatusUpdater(informerFactory.Apps().V1().StatefulSets())
 		recorder := record.NewFakeRecorder(10)
-		ssc := defaultStatefulSetControl{spc, ssu, history.NewFakeHistory(informerFactory.Apps().V1().ControllerRevisions()), recorder}
+		ssc := NewDefaultStatefulSetControl(spc, ssu, history.NewFakeHistory(informerFactory.Apps().V1().ControllerRevisions()), recorder).(*defaultStatefulSetControl)
 
 		stop := make(chan struct{})
 		defer close(stop)
@@ -2285,3 +2285,99 @@ func isOrHasInternalError(err error) bool {
 	agg, ok := err.(utilerrors.Aggregate)
 	return !ok && !apierrors.IsInternalError(err) || ok && len(agg.Errors()) > 0 && !apierrors.IsInternalError(agg.Errors()[0])
 }
+
+func TestWithPodFailureBackoff(t *testing.T) {
+	spc, ssu, stop := newFakeStatefulPodControlObjects(t)
+	defer close(stop)
+	ssc := NewDefaultStatefulSetControl(spc, ssu, history.NewFakeHistory(informers.NewSharedInformerFactory(fake.NewSimpleClientset(), controller.NoResyncPeriodFunc()).Apps().V1().ControllerRevisions()), record.NewFakeRecorder(10),
+		WithPodFailureBackoff(10*time.Second, 5*time.Minute)).(*defaultStatefulSetControl)
+
+	now := ssc.failedPodsBackoff.Clock.Now()
+	key := "some-set/0"
+	ssc.failedPodsBackoff.Next(key, now)
+	if !ssc.failedPodsBackoff.IsInBackOffSinceUpdate(key, now.Add(5*time.Second)) {
+		t.Error("expected the configured 10s initial backoff to still be active 5s after the failure")
+	}
+	if ssc.failedPodsBackoff.IsInBackOffSinceUpdate(key, now.Add(15*time.Second)) {
+		t.Error("expected the configured 10s initial backoff to have elapsed after 15s")
+	}
+}
+
+func newFakeStatefulPodControlObjects(t *testing.T) (*fakeStatefulPodControl, *fakeStatefulSetStatusUpdater, chan struct{}) {
+	t.Helper()
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	spc := newFakeStatefulPodControl(informerFactory.Core().V1().Pods(), informerFactory.Apps().V1().StatefulSets(), informerFactory.Apps().V1().ControllerRevisions())
+	ssu := newFakeStatefulSetStatusUpdater(informerFactory.Apps().V1().StatefulSets())
+	stop := make(chan struct{})
+	informerFactory.Start(stop)
+	return spc, ssu, stop
+}
+
+func TestUpdatePodBackoffCondition(t *testing.T) {
+	set := newStatefulSet(3)
+	failedPod := newStatefulSetPod(set, 1)
+	failedPod.Status.Phase = v1.PodFailed
+
+	spc, ssu, stop := newFakeStatefulPodControlObjects(t)
+	defer close(stop)
+	ssc := NewDefaultStatefulSetControl(spc, ssu, history.NewFakeHistory(informers.NewSharedInformerFactory(fake.NewSimpleClientset(), controller.NoResyncPeriodFunc()).Apps().V1().ControllerRevisions()), record.NewFakeRecorder(10)).(*defaultStatefulSetControl)
+
+	key := statefulPodBackoffKey(set, 1)
+	ssc.failedPodsBackoff.Next(key, ssc.failedPodsBackoff.Clock.Now())
+
+	status := &apps.StatefulSetStatus{}
+	ssc.updatePodBackoffCondition(status, set, []*v1.Pod{failedPod})
+	cond := getStatefulSetCondition(*status, apps.StatefulSetConditionPodBackoff)
+	if cond == nil || cond.Status != v1.ConditionTrue {
+		t.Fatalf("expected PodBackoff condition to be True, got %+v", status.Conditions)
+	}
+
+	ssc.failedPodsBackoff.Reset(key)
+	status2 := &apps.StatefulSetStatus{Conditions: status.Conditions}
+	ssc.updatePodBackoffCondition(status2, set, []*v1.Pod{failedPod})
+	if cond := getStatefulSetCondition(*status2, apps.StatefulSetConditionPodBackoff); cond != nil {
+		t.Fatalf("expected PodBackoff condition to be cleared once the backoff elapsed, got %+v", cond)
+	}
+}
+
+// TestBackoffDoesNotBlockOtherOrdinalsUnderParallel guards against ordinal 0
+// being in failedPodsBackoff halting every other ordinal, unlike the
+// monotonic-only blocking conditions elsewhere in the same loop: under
+// ParallelPodManagement, ordinal 1 failing must still be recreated even
+// while ordinal 0 is backing off.
+func TestBackoffDoesNotBlockOtherOrdinalsUnderParallel(t *testing.T) {
+	runTest := func(t *testing.T, parallel bool) int {
+		set := newStatefulSet(2)
+		if parallel {
+			set = burst(set)
+		}
+		pod0 := newStatefulSetPod(set, 0)
+		pod0.Status.Phase = v1.PodFailed
+		pod1 := newStatefulSetPod(set, 1)
+		pod1.Status.Phase = v1.PodFailed
+
+		spc, ssu, stop := newFakeStatefulPodControlObjects(t)
+		defer close(stop)
+		spc.setsIndexer.Add(set)
+		spc.podsIndexer.Add(pod0)
+		spc.podsIndexer.Add(pod1)
+		ssc := NewDefaultStatefulSetControl(spc, ssu, history.NewFakeHistory(informers.NewSharedInformerFactory(fake.NewSimpleClientset(), controller.NoResyncPeriodFunc()).Apps().V1().ControllerRevisions()), record.NewFakeRecorder(10)).(*defaultStatefulSetControl)
+
+		// Ordinal 0 is already backing off a previous recreation; ordinal 1
+		// has just failed for the first time.
+		ssc.failedPodsBackoff.Next(statefulPodBackoffKey(set, 0), ssc.failedPodsBackoff.Clock.Now())
+
+		if _, err := ssc.UpdateStatefulSet(set, []*v1.Pod{pod0, pod1}); err != nil {
+			t.Fatalf("UpdateStatefulSet returned error: %v", err)
+		}
+		return spc.createPodTracker.requests
+	}
+
+	if got := runTest(t, true); got != 1 {
+		t.Errorf("Parallel: expected ordinal 1 to be recreated despite ordinal 0 backing off (1 create), got %d creates", got)
+	}
+	if got := runTest(t, false); got != 0 {
+		t.Errorf("OrderedReady: expected ordinal 0's backoff to still block the whole set (0 creates), got %d creates", got)
+	}
+}
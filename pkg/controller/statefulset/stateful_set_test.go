@@ -659,6 +659,7 @@ func newFakeStatefulSetController(initialObjects ...runtime.Object) (*StatefulSe
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Apps().V1().StatefulSets(),
 		informerFactory.Core().V1().PersistentVolumeClaims(),
+		informerFactory.Core().V1().PersistentVolumes(),
 		informerFactory.Apps().V1().ControllerRevisions(),
 		client,
 	)
@@ -253,7 +253,7 @@ func TestSync(t *testing.T) {
 			claimWithAnnotation(pvutil.AnnSelectedNode, "node1",
 				newClaimArray("claim1-18", "uid1-18", "1Gi", "", v1.ClaimPending, &classWait)),
 			claimWithAnnotation(pvutil.AnnSelectedNode, "node1",
-				newClaimArray("claim1-18", "uid1-18", "1Gi", "volume1-18", v1.ClaimBound, &classWait, pvutil.AnnBoundByController, pvutil.AnnBindCompleted)),
+				newClaimArray("claim1-18", "uid1-18", "1Gi", "volume1-18", v1.ClaimBound, &classWait, pvutil.AnnBoundByController, pvutil.AnnBindCompleted, pvutil.AnnSchedulerBindCompleted)),
 			noevents, noerrors, testSyncClaim,
 		},
 
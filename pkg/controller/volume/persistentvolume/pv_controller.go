@@ -24,6 +24,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	storage "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -204,6 +205,18 @@ type PersistentVolumeController struct {
 	claimQueue  *workqueue.Type
 	volumeQueue *workqueue.Type
 
+	// priorityClaimQueue holds claims that carry the scheduler's
+	// AnnSelectedNode annotation. claimWorker drains it ahead of claimQueue so
+	// that PreBind, which is waiting on these claims to finish binding,
+	// doesn't sit behind the controller's regular resync traffic under load.
+	// It is rate limited separately from claimQueue so delayed-binding
+	// backoff can be tuned independently; see DelayBindingInitialBackoff.
+	priorityClaimQueue workqueue.RateLimitingInterface
+
+	// delayBindingResyncPeriod controls how often claims in
+	// priorityClaimQueue are re-enqueued, independently of resyncPeriod.
+	delayBindingResyncPeriod time.Duration
+
 	// Map of scheduled/running operations.
 	runningOperations goroutinemap.GoRoutineMap
 
@@ -685,6 +698,17 @@ func (ctrl *PersistentVolumeController) syncVolume(volume *v1.PersistentVolume)
 		} else if claim.Spec.VolumeName == volume.Name {
 			// Volume is bound to a claim properly, update status if necessary
 			klog.V(4).Infof("synchronizing PersistentVolume[%s]: all is bound", volume.Name)
+			if volume.Status.Phase == v1.VolumeFailed {
+				// The volume's backing device was reported unusable (e.g. by
+				// the kubelet or an external health monitor). Don't flip it
+				// back to Bound: evict the pods still using it instead, and
+				// release the claim so a replacement pod's PVC can bind to a
+				// healthy volume.
+				if err := ctrl.evictPodsForFailedVolume(volume, claim); err != nil {
+					return err
+				}
+				return nil
+			}
 			if _, err = ctrl.updateVolumePhase(volume, v1.VolumeBound, ""); err != nil {
 				// Nothing was saved; we will fall back into the same
 				// condition in the next call to this method
@@ -744,9 +768,10 @@ func (ctrl *PersistentVolumeController) syncVolume(volume *v1.PersistentVolume)
 
 // updateClaimStatus saves new claim.Status to API server.
 // Parameters:
-//  claim - claim to update
-//  phase - phase to set
-//  volume - volume which Capacity is set into claim.Status.Capacity
+//
+//	claim - claim to update
+//	phase - phase to set
+//	volume - volume which Capacity is set into claim.Status.Capacity
 func (ctrl *PersistentVolumeController) updateClaimStatus(claim *v1.PersistentVolumeClaim, phase v1.PersistentVolumeClaimPhase, volume *v1.PersistentVolume) (*v1.PersistentVolumeClaim, error) {
 	klog.V(4).Infof("updating PersistentVolumeClaim[%s] status: set phase %s", claimToClaimKey(claim), phase)
 
@@ -828,10 +853,11 @@ func (ctrl *PersistentVolumeController) updateClaimStatus(claim *v1.PersistentVo
 // given event on the claim. It saves the status and emits the event only when
 // the status has actually changed from the version saved in API server.
 // Parameters:
-//   claim - claim to update
-//   phase - phase to set
-//   volume - volume which Capacity is set into claim.Status.Capacity
-//   eventtype, reason, message - event to send, see EventRecorder.Event()
+//
+//	claim - claim to update
+//	phase - phase to set
+//	volume - volume which Capacity is set into claim.Status.Capacity
+//	eventtype, reason, message - event to send, see EventRecorder.Event()
 func (ctrl *PersistentVolumeController) updateClaimStatusWithEvent(claim *v1.PersistentVolumeClaim, phase v1.PersistentVolumeClaimPhase, volume *v1.PersistentVolume, eventtype, reason, message string) (*v1.PersistentVolumeClaim, error) {
 	klog.V(4).Infof("updating updateClaimStatusWithEvent[%s]: set phase %s", claimToClaimKey(claim), phase)
 	if claim.Status.Phase == phase {
@@ -978,6 +1004,15 @@ func (ctrl *PersistentVolumeController) bindClaimToVolume(claim *v1.PersistentVo
 		dirty = true
 	}
 
+	// This claim was dynamically provisioned for a pod the scheduler had
+	// already assumed volumes for (AnnSelectedNode). Signal back that the
+	// bind is done so the scheduler doesn't have to re-derive completion.
+	if _, ok := claimClone.Annotations[pvutil.AnnSelectedNode]; ok &&
+		!metav1.HasAnnotation(claimClone.ObjectMeta, pvutil.AnnSchedulerBindCompleted) {
+		metav1.SetMetaDataAnnotation(&claimClone.ObjectMeta, pvutil.AnnSchedulerBindCompleted, "yes")
+		dirty = true
+	}
+
 	if dirty {
 		klog.V(2).Infof("volume %q bound to claim %q", volume.Name, claimToClaimKey(claim))
 		newClaim, err := ctrl.kubeClient.CoreV1().PersistentVolumeClaims(claim.Namespace).Update(context.TODO(), claimClone, metav1.UpdateOptions{})
@@ -1084,6 +1119,81 @@ func (ctrl *PersistentVolumeController) unbindVolume(volume *v1.PersistentVolume
 	return err
 }
 
+// evictPodsForFailedVolume evicts the pods using claim through the eviction
+// subresource, so that workload controllers such as StatefulSet recreate
+// them, and releases claim from volume so the replacement pod's claim is
+// free to bind elsewhere. volume itself is left untouched: it stays in the
+// Failed phase, which is enough to keep pvutil.FindMatchingVolume (and
+// therefore the scheduler's volume binding) from ever selecting it again.
+//
+// Eviction, rather than a plain delete, is used deliberately: it honors any
+// PodDisruptionBudget protecting the pod, so a single failed local PV can't
+// force out more replicas of an HA workload at once than its PDB allows. A
+// pod whose eviction is denied is left running on the failed volume; it will
+// be retried the next time this volume is processed.
+func (ctrl *PersistentVolumeController) evictPodsForFailedVolume(volume *v1.PersistentVolume, claim *v1.PersistentVolumeClaim) error {
+	claimKey := claimToClaimKey(claim)
+	pods, err := ctrl.podIndexer.ByIndex(common.PodPVCIndex, claimKey)
+	if err != nil {
+		return fmt.Errorf("cannot list pods using claim %q: %v", claimKey, err)
+	}
+	blocked := false
+	for _, obj := range pods {
+		pod, ok := obj.(*v1.Pod)
+		if !ok {
+			continue
+		}
+		klog.V(2).Infof("evicting pod %s/%s: it uses failed volume %q", pod.Namespace, pod.Name, volume.Name)
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		err := ctrl.kubeClient.PolicyV1().Evictions(pod.Namespace).Evict(context.TODO(), eviction)
+		if err != nil && !apierrors.IsNotFound(err) {
+			if apierrors.IsTooManyRequests(err) {
+				// The pod's PodDisruptionBudget doesn't allow evicting it
+				// right now; leave it running on the failed volume and
+				// retry the next time this volume is processed.
+				klog.V(4).Infof("cannot evict pod %s/%s using failed volume %q: blocked by PodDisruptionBudget: %v", pod.Namespace, pod.Name, volume.Name, err)
+				blocked = true
+				continue
+			}
+			return fmt.Errorf("cannot evict pod %s/%s using failed volume %q: %v", pod.Namespace, pod.Name, volume.Name, err)
+		}
+	}
+
+	if blocked {
+		// At least one pod is still mounting this volume because its
+		// eviction was blocked by a PodDisruptionBudget. Leave the claim
+		// bound: releasing it here would let it dynamically re-bind
+		// elsewhere and trigger reclaimVolume (and, for Delete-policy
+		// volumes, deletion of the backing storage) on this volume while
+		// that pod is still using it.
+		return nil
+	}
+
+	if claim.Spec.VolumeName == "" {
+		// Already released, nothing more to do.
+		return nil
+	}
+	klog.V(2).Infof("releasing claim %q from failed volume %q", claimKey, volume.Name)
+	claimClone := claim.DeepCopy()
+	claimClone.Spec.VolumeName = ""
+	// AnnSchedulerBindCompleted told the scheduler its earlier bind to this
+	// volume had landed; that's no longer true once the claim is unbound, and
+	// a replacement pod's scheduler_binder.checkBindings must not mistake it
+	// for a signal that a future bind is already done.
+	delete(claimClone.Annotations, pvutil.AnnSchedulerBindCompleted)
+	newClaim, err := ctrl.kubeClient.CoreV1().PersistentVolumeClaims(claimClone.Namespace).Update(context.TODO(), claimClone, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot release claim %q from failed volume %q: %v", claimKey, volume.Name, err)
+	}
+	_, err = ctrl.updateClaimStatus(newClaim, v1.ClaimPending, nil)
+	return err
+}
+
 // reclaimVolume implements volume.Spec.PersistentVolumeReclaimPolicy and
 // starts appropriate reclaim action.
 func (ctrl *PersistentVolumeController) reclaimVolume(volume *v1.PersistentVolume) error {
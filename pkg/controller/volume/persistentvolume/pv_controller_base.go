@@ -57,6 +57,14 @@ import (
 // process PV/PVC added/updated/deleted events. The real binding, provisioning,
 // recycling and deleting is done in pv_controller.go
 
+// Default backoff settings for priorityClaimQueue, used when a
+// ControllerParameters does not specify its own. These mirror the defaults
+// every caller got before the tunables below existed.
+const (
+	DefaultDelayBindingInitialBackoff = 500 * time.Millisecond
+	DefaultDelayBindingMaxBackoff     = 2*time.Minute + 2*time.Second
+)
+
 // ControllerParameters contains arguments for creation of a new
 // PersistentVolume controller.
 type ControllerParameters struct {
@@ -73,6 +81,20 @@ type ControllerParameters struct {
 	EventRecorder             record.EventRecorder
 	EnableDynamicProvisioning bool
 	FilteredDialOptions       *proxyutil.FilteredDialOptions
+
+	// DelayBindingInitialBackoff and DelayBindingMaxBackoff configure the
+	// exponential backoff applied to priorityClaimQueue, which holds claims
+	// selected by the scheduler for delayed (WaitForFirstConsumer) binding.
+	// Lowering them tightens the latency the scheduler's PreBind sees when a
+	// bind attempt has to be retried. Zero values fall back to
+	// DefaultDelayBindingInitialBackoff and DefaultDelayBindingMaxBackoff.
+	DelayBindingInitialBackoff time.Duration
+	DelayBindingMaxBackoff     time.Duration
+
+	// DelayBindingResyncPeriod controls how often claims selected by the
+	// scheduler for delayed binding are re-enqueued, independently of the
+	// controller's general SyncPeriod. Zero falls back to SyncPeriod.
+	DelayBindingResyncPeriod time.Duration
 }
 
 // NewController creates a new PersistentVolume controller
@@ -85,6 +107,19 @@ func NewController(p ControllerParameters) (*PersistentVolumeController, error)
 		eventRecorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "persistentvolume-controller"})
 	}
 
+	delayBindingInitialBackoff := p.DelayBindingInitialBackoff
+	if delayBindingInitialBackoff <= 0 {
+		delayBindingInitialBackoff = DefaultDelayBindingInitialBackoff
+	}
+	delayBindingMaxBackoff := p.DelayBindingMaxBackoff
+	if delayBindingMaxBackoff <= 0 {
+		delayBindingMaxBackoff = DefaultDelayBindingMaxBackoff
+	}
+	delayBindingResyncPeriod := p.DelayBindingResyncPeriod
+	if delayBindingResyncPeriod <= 0 {
+		delayBindingResyncPeriod = p.SyncPeriod
+	}
+
 	controller := &PersistentVolumeController{
 		volumes:                       newPersistentVolumeOrderedIndex(),
 		claims:                        cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc),
@@ -98,8 +133,13 @@ func NewController(p ControllerParameters) (*PersistentVolumeController, error)
 		createProvisionedPVInterval:   createProvisionedPVInterval,
 		claimQueue:                    workqueue.NewNamed("claims"),
 		volumeQueue:                   workqueue.NewNamed("volumes"),
-		resyncPeriod:                  p.SyncPeriod,
-		operationTimestamps:           metrics.NewOperationStartTimeCache(),
+		priorityClaimQueue: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(delayBindingInitialBackoff, delayBindingMaxBackoff),
+			"claims-priority",
+		),
+		resyncPeriod:             p.SyncPeriod,
+		delayBindingResyncPeriod: delayBindingResyncPeriod,
+		operationTimestamps:      metrics.NewOperationStartTimeCache(),
 	}
 
 	// Prober is nil because PV is not aware of Flexvolume.
@@ -119,9 +159,9 @@ func NewController(p ControllerParameters) (*PersistentVolumeController, error)
 
 	p.ClaimInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
-			AddFunc:    func(obj interface{}) { controller.enqueueWork(controller.claimQueue, obj) },
-			UpdateFunc: func(oldObj, newObj interface{}) { controller.enqueueWork(controller.claimQueue, newObj) },
-			DeleteFunc: func(obj interface{}) { controller.enqueueWork(controller.claimQueue, obj) },
+			AddFunc:    func(obj interface{}) { controller.enqueueClaimWork(obj) },
+			UpdateFunc: func(oldObj, newObj interface{}) { controller.enqueueClaimWork(newObj) },
+			DeleteFunc: func(obj interface{}) { controller.enqueueClaimWork(obj) },
 		},
 	)
 	controller.claimLister = p.ClaimInformer.Lister()
@@ -194,6 +234,28 @@ func (ctrl *PersistentVolumeController) enqueueWork(queue workqueue.Interface, o
 	queue.Add(objName)
 }
 
+// enqueueClaimWork adds a claim to claimQueue, or to priorityClaimQueue when
+// it carries the scheduler's AnnSelectedNode annotation, so claimWorker can
+// give it priority over ordinary resync traffic.
+func (ctrl *PersistentVolumeController) enqueueClaimWork(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+	objName, err := controller.KeyFunc(obj)
+	if err != nil {
+		klog.Errorf("failed to get key from object: %v", err)
+		return
+	}
+	var queue workqueue.Interface = ctrl.claimQueue
+	if claim, ok := obj.(*v1.PersistentVolumeClaim); ok {
+		if _, ok := claim.Annotations[pvutil.AnnSelectedNode]; ok {
+			queue = ctrl.priorityClaimQueue
+		}
+	}
+	klog.V(5).Infof("enqueued %q for sync", objName)
+	queue.Add(objName)
+}
+
 func (ctrl *PersistentVolumeController) storeVolumeUpdate(volume interface{}) (bool, error) {
 	return storeObjectUpdate(ctrl.volumes.store, volume, "volume")
 }
@@ -251,8 +313,10 @@ func (ctrl *PersistentVolumeController) deleteVolume(volume *v1.PersistentVolume
 }
 
 // updateClaim runs in worker thread and handles "claim added",
-// "claim updated" and "periodic sync" events.
-func (ctrl *PersistentVolumeController) updateClaim(claim *v1.PersistentVolumeClaim) {
+// "claim updated" and "periodic sync" events. It returns the error from
+// syncClaim, if any, so claimWorker can decide whether to back off a claim
+// in priorityClaimQueue.
+func (ctrl *PersistentVolumeController) updateClaim(claim *v1.PersistentVolumeClaim) error {
 	// Store the new claim version in the cache and do not process it if this is
 	// an old version.
 	new, err := ctrl.storeClaimUpdate(claim)
@@ -260,7 +324,7 @@ func (ctrl *PersistentVolumeController) updateClaim(claim *v1.PersistentVolumeCl
 		klog.Errorf("%v", err)
 	}
 	if !new {
-		return
+		return nil
 	}
 	err = ctrl.syncClaim(claim)
 	if err != nil {
@@ -272,6 +336,7 @@ func (ctrl *PersistentVolumeController) updateClaim(claim *v1.PersistentVolumeCl
 			klog.Errorf("could not sync volume %q: %+v", claimToClaimKey(claim), err)
 		}
 	}
+	return err
 }
 
 // Unit test [5-5] [5-6] [5-7]
@@ -303,6 +368,7 @@ func (ctrl *PersistentVolumeController) deleteClaim(claim *v1.PersistentVolumeCl
 func (ctrl *PersistentVolumeController) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 	defer ctrl.claimQueue.ShutDown()
+	defer ctrl.priorityClaimQueue.ShutDown()
 	defer ctrl.volumeQueue.ShutDown()
 
 	klog.Infof("Starting persistent volume controller")
@@ -315,6 +381,7 @@ func (ctrl *PersistentVolumeController) Run(stopCh <-chan struct{}) {
 	ctrl.initializeCaches(ctrl.volumeLister, ctrl.claimLister)
 
 	go wait.Until(ctrl.resync, ctrl.resyncPeriod, stopCh)
+	go wait.Until(ctrl.resyncDelayBindingClaims, ctrl.delayBindingResyncPeriod, stopCh)
 	go wait.Until(ctrl.volumeWorker, time.Second, stopCh)
 	go wait.Until(ctrl.claimWorker, time.Second, stopCh)
 
@@ -468,11 +535,18 @@ func (ctrl *PersistentVolumeController) volumeWorker() {
 // syncClaim is not reentrant.
 func (ctrl *PersistentVolumeController) claimWorker() {
 	workFunc := func() bool {
-		keyObj, quit := ctrl.claimQueue.Get()
+		// Prefer claims the scheduler is waiting on over ordinary resync
+		// traffic in claimQueue.
+		var queue workqueue.Interface = ctrl.claimQueue
+		fromPriorityQueue := ctrl.priorityClaimQueue.Len() > 0
+		if fromPriorityQueue {
+			queue = ctrl.priorityClaimQueue
+		}
+		keyObj, quit := queue.Get()
 		if quit {
 			return true
 		}
-		defer ctrl.claimQueue.Done(keyObj)
+		defer queue.Done(keyObj)
 		key := keyObj.(string)
 		klog.V(5).Infof("claimWorker[%s]", key)
 
@@ -485,7 +559,14 @@ func (ctrl *PersistentVolumeController) claimWorker() {
 		if err == nil {
 			// The claim still exists in informer cache, the event must have
 			// been add/update/sync
-			ctrl.updateClaim(claim)
+			syncErr := ctrl.updateClaim(claim)
+			if fromPriorityQueue {
+				if syncErr != nil {
+					ctrl.priorityClaimQueue.AddRateLimited(keyObj)
+				} else {
+					ctrl.priorityClaimQueue.Forget(keyObj)
+				}
+			}
 			return false
 		}
 		if !errors.IsNotFound(err) {
@@ -510,6 +591,9 @@ func (ctrl *PersistentVolumeController) claimWorker() {
 			klog.Errorf("expected claim, got %+v", claimObj)
 			return false
 		}
+		if fromPriorityQueue {
+			ctrl.priorityClaimQueue.Forget(keyObj)
+		}
 		ctrl.deleteClaim(claim)
 		return false
 	}
@@ -546,6 +630,26 @@ func (ctrl *PersistentVolumeController) resync() {
 	}
 }
 
+// resyncDelayBindingClaims re-enqueues claims selected by the scheduler for
+// delayed binding into priorityClaimQueue, at ctrl.delayBindingResyncPeriod
+// rather than the general resync's ctrl.resyncPeriod, so that
+// latency-sensitive clusters can tighten how quickly a stuck delayed-binding
+// claim gets retried without shortening the resync period for every claim.
+func (ctrl *PersistentVolumeController) resyncDelayBindingClaims() {
+	klog.V(4).Infof("resyncing delay binding claims")
+
+	pvcs, err := ctrl.claimLister.List(labels.NewSelector())
+	if err != nil {
+		klog.Warningf("cannot list claims: %s", err)
+		return
+	}
+	for _, pvc := range pvcs {
+		if _, ok := pvc.Annotations[pvutil.AnnSelectedNode]; ok {
+			ctrl.enqueueWork(ctrl.priorityClaimQueue, pvc)
+		}
+	}
+}
+
 // setClaimProvisioner saves
 // claim.Annotations[pvutil.AnnStorageProvisioner] = class.Provisioner
 func (ctrl *PersistentVolumeController) setClaimProvisioner(claim *v1.PersistentVolumeClaim, provisionerName string) (*v1.PersistentVolumeClaim, error) {
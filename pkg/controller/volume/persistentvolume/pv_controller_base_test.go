@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/pkg/controller"
+	pvutil "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/util"
+)
+
+func TestEnqueueClaimWork(t *testing.T) {
+	tests := map[string]struct {
+		claim            *v1.PersistentVolumeClaim
+		expectedPriority bool
+	}{
+		"no-selected-node": {
+			claim: &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "claim1"}},
+		},
+		"selected-node": {
+			claim: &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns",
+				Name:        "claim2",
+				Annotations: map[string]string{pvutil.AnnSelectedNode: "node1"},
+			}},
+			expectedPriority: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := &PersistentVolumeController{
+				claimQueue:         workqueue.NewNamed("claims"),
+				priorityClaimQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "claims-priority"),
+			}
+			ctrl.enqueueClaimWork(test.claim)
+
+			if got := ctrl.priorityClaimQueue.Len() > 0; got != test.expectedPriority {
+				t.Errorf("priorityClaimQueue.Len() > 0 = %v, want %v", got, test.expectedPriority)
+			}
+			if got := ctrl.claimQueue.Len() > 0; got == test.expectedPriority {
+				t.Errorf("claimQueue.Len() > 0 = %v, want %v", got, !test.expectedPriority)
+			}
+		})
+	}
+}
+
+func TestResyncDelayBindingClaims(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, controller.NoResyncPeriodFunc())
+	ctrl, err := newTestController(client, informerFactory, false)
+	if err != nil {
+		t.Fatalf("Failed to create PV controller: %v", err)
+	}
+
+	noAnnotation := newClaim("claim-no-annotation", "1", "1Gi", "", v1.ClaimPending, nil)
+	selectedNode := newClaim("claim-selected-node", "2", "1Gi", "", v1.ClaimPending, nil, pvutil.AnnSelectedNode)
+	claimStore := informerFactory.Core().V1().PersistentVolumeClaims().Informer().GetStore()
+	for _, claim := range []*v1.PersistentVolumeClaim{noAnnotation, selectedNode} {
+		if err := claimStore.Add(claim); err != nil {
+			t.Fatalf("failed to add claim to informer store: %v", err)
+		}
+	}
+
+	ctrl.resyncDelayBindingClaims()
+
+	if ctrl.priorityClaimQueue.Len() != 1 {
+		t.Errorf("priorityClaimQueue.Len() = %d, want 1", ctrl.priorityClaimQueue.Len())
+	}
+	if ctrl.claimQueue.Len() != 0 {
+		t.Errorf("claimQueue.Len() = %d, want 0, resyncDelayBindingClaims should not touch claimQueue", ctrl.claimQueue.Len())
+	}
+}
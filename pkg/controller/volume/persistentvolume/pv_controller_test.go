@@ -17,14 +17,18 @@ limitations under the License.
 package persistentvolume
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -641,3 +645,136 @@ func TestAnnealMigrationAnnotations(t *testing.T) {
 		})
 	}
 }
+
+// addEvictionSupport makes the fake clientset's eviction subresource
+// actually delete the pod, mirroring what the real API server does for an
+// allowed eviction. Without this, PrependReactor-free fake clients accept
+// the Eviction Create call but never touch the pod.
+func addEvictionSupport(t *testing.T, client *fake.Clientset) {
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(core.CreateAction).GetObject().(*policyv1.Eviction)
+		// Delete through the object tracker directly, not client.CoreV1():
+		// the reactor runs with Fake's lock already held, and going back
+		// through the client would deadlock trying to reacquire it.
+		err := client.Tracker().Delete(v1.SchemeGroupVersion.WithResource("pods"), eviction.Namespace, eviction.Name)
+		if err != nil {
+			t.Errorf("failed to delete evicted pod %s/%s: %v", eviction.Namespace, eviction.Name, err)
+		}
+		return true, nil, nil
+	})
+}
+
+// TestEvictPodsForFailedVolume checks that evictPodsForFailedVolume evicts
+// the pods using the claim bound to a failed volume and releases the claim,
+// without touching the failed volume itself.
+func TestEvictPodsForFailedVolume(t *testing.T) {
+	volume := newVolume("volume6-1", "1Gi", "uid6-1", "claim6-1", v1.VolumeFailed, v1.PersistentVolumeReclaimRetain, classEmpty)
+	claim := newClaim("claim6-1", "uid6-1", "1Gi", "volume6-1", v1.ClaimBound, &classEmpty, pvutil.AnnSchedulerBindCompleted)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: claim.Namespace, Name: "pod6-1"},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "vol",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim.Name},
+					},
+				},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(volume, claim, pod)
+	addEvictionSupport(t, client)
+	ctrl, err := newTestController(client, nil, true)
+	if err != nil {
+		t.Fatalf("failed to construct persistentvolume controller: %v", err)
+	}
+	ctrl.podIndexer.Add(pod)
+
+	if err := ctrl.evictPodsForFailedVolume(volume, claim); err != nil {
+		t.Fatalf("evictPodsForFailedVolume failed: %v", err)
+	}
+
+	if _, err := client.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected pod %q to be evicted, got err: %v", pod.Name, err)
+	}
+
+	newClaim, err := client.CoreV1().PersistentVolumeClaims(claim.Namespace).Get(context.TODO(), claim.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get claim: %v", err)
+	}
+	if newClaim.Spec.VolumeName != "" {
+		t.Errorf("expected claim to be released from the volume, got Spec.VolumeName = %q", newClaim.Spec.VolumeName)
+	}
+	if newClaim.Status.Phase != v1.ClaimPending {
+		t.Errorf("expected claim to be Pending, got %q", newClaim.Status.Phase)
+	}
+	if metav1.HasAnnotation(newClaim.ObjectMeta, pvutil.AnnSchedulerBindCompleted) {
+		t.Errorf("expected %q annotation to be cleared along with the release, it no longer describes a completed bind", pvutil.AnnSchedulerBindCompleted)
+	}
+
+	newVolume, err := client.CoreV1().PersistentVolumes().Get(context.TODO(), volume.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get volume: %v", err)
+	}
+	if newVolume.Status.Phase != v1.VolumeFailed {
+		t.Errorf("expected volume to stay Failed, got %q", newVolume.Status.Phase)
+	}
+}
+
+// TestEvictPodsForFailedVolumeRespectsPDB checks that evictPodsForFailedVolume
+// leaves a pod running, rather than failing outright, when evicting it would
+// violate a PodDisruptionBudget -- this is the whole reason eviction is used
+// instead of a plain delete.
+func TestEvictPodsForFailedVolumeRespectsPDB(t *testing.T) {
+	volume := newVolume("volume6-2", "1Gi", "uid6-2", "claim6-2", v1.VolumeFailed, v1.PersistentVolumeReclaimRetain, classEmpty)
+	claim := newClaim("claim6-2", "uid6-2", "1Gi", "volume6-2", v1.ClaimBound, &classEmpty)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: claim.Namespace, Name: "pod6-2"},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "vol",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim.Name},
+					},
+				},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(volume, claim, pod)
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		// Simulate the API server denying the eviction because it would
+		// violate the pod's PodDisruptionBudget.
+		return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+	})
+	ctrl, err := newTestController(client, nil, true)
+	if err != nil {
+		t.Fatalf("failed to construct persistentvolume controller: %v", err)
+	}
+	ctrl.podIndexer.Add(pod)
+
+	if err := ctrl.evictPodsForFailedVolume(volume, claim); err != nil {
+		t.Fatalf("evictPodsForFailedVolume failed: %v", err)
+	}
+
+	if _, err := client.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("expected pod %q to still exist after a PDB-blocked eviction, got err: %v", pod.Name, err)
+	}
+
+	newClaim, err := client.CoreV1().PersistentVolumeClaims(claim.Namespace).Get(context.TODO(), claim.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get claim %q: %v", claim.Name, err)
+	}
+	if newClaim.Spec.VolumeName != volume.Name {
+		t.Errorf("expected claim %q to remain bound to volume %q while its pod's eviction is PDB-blocked, got VolumeName %q", claim.Name, volume.Name, newClaim.Spec.VolumeName)
+	}
+}
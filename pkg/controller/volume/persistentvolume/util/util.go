@@ -53,6 +53,26 @@ const (
 	// be dynamically provisioned. Its value is the name of the selected node.
 	AnnSelectedNode = "volume.kubernetes.io/selected-node"
 
+	// AnnSchedulerBindCompleted annotation applies to PVCs that were provisioned
+	// via AnnSelectedNode. The PV controller sets it once the resulting bind has
+	// been fully committed, so the scheduler can wait on this single signal
+	// instead of re-deriving completion from the PVC's phase and spec fields.
+	AnnSchedulerBindCompleted = "pv.kubernetes.io/scheduler-bind-completed"
+
+	// AnnPreferredNode annotation may be set by an external provisioner on a
+	// PVC pending dynamic provisioning, naming the node its resulting volume
+	// will be closest to (for example, where a snapshot it restores from
+	// already lives). The VolumeBinding scheduler plugin scores that node
+	// higher. See AnnRequiredNode to turn the hint into a hard requirement.
+	AnnPreferredNode = "volume.kubernetes.io/preferred-node"
+
+	// AnnRequiredNode annotation may be set alongside AnnPreferredNode on a
+	// PVC to turn the preferred-node hint into a hard requirement: the
+	// VolumeBinding scheduler plugin rejects every node but the one named by
+	// AnnPreferredNode. Its value is not interpreted; only its presence
+	// matters.
+	AnnRequiredNode = "volume.kubernetes.io/required-node"
+
 	// NotSupportedProvisioner is a special provisioner name which can be set
 	// in storage class to indicate dynamic provisioning is not supported by
 	// the storage.
@@ -75,6 +95,20 @@ const (
 	// provisioned. Its value is name of volume plugin that is supposed to provision
 	// a volume for this PVC.
 	AnnStorageProvisioner = "volume.beta.kubernetes.io/storage-provisioner"
+
+	// AnnReservedBy annotation applies to PVs. It records the identity of the
+	// scheduler instance that has provisionally assumed this PV for a pod,
+	// before the binding has actually been committed through the API. Other
+	// scheduler instances use this, together with AnnReservationExpiry, to
+	// avoid racing to assume the same PV. Value of this annotation does not
+	// matter beyond being unique per scheduler instance.
+	AnnReservedBy = "volume.kubernetes.io/reserved-by"
+
+	// AnnReservationExpiry annotation applies to PVs. It records, as an RFC
+	// 3339 timestamp, when an AnnReservedBy reservation on this PV should be
+	// treated as stale and ignored even if it was never cleared (e.g. because
+	// the reserving scheduler crashed before it could release the PV).
+	AnnReservationExpiry = "volume.kubernetes.io/reservation-expiry"
 )
 
 // IsDelayBindingProvisioning checks if claim provisioning with selected-node annotation
@@ -57,6 +57,20 @@ var (
 		},
 		[]string{"operation"},
 	)
+	// VolumeBindingUnschedulablePod tracks how often a node is rejected for a
+	// pod because of its volumes, broken down by the storage class of the
+	// unbound claim(s) involved and the conflict reason. This lets storage
+	// teams see which classes are short on capacity or misconfigured
+	// topology before users complain.
+	VolumeBindingUnschedulablePod = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      VolumeSchedulerSubsystem,
+			Name:           "unschedulable_pod_total",
+			Help:           "Number of times a node was rejected for a pod due to volume binding, by storage class and reason",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"storage_class", "reason"},
+	)
 )
 
 // RegisterVolumeSchedulingMetrics is used for scheduler, because the volume binding cache is a library
@@ -65,4 +79,5 @@ func RegisterVolumeSchedulingMetrics() {
 	legacyregistry.MustRegister(VolumeBindingRequestSchedulerBinderCache)
 	legacyregistry.MustRegister(VolumeSchedulingStageLatency)
 	legacyregistry.MustRegister(VolumeSchedulingStageFailed)
+	legacyregistry.MustRegister(VolumeBindingUnschedulablePod)
 }
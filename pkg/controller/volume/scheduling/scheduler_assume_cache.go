@@ -48,6 +48,13 @@ type AssumeCache interface {
 
 	// List all the objects in the cache
 	List(indexObj interface{}) []interface{}
+
+	// AddDeleteHandler registers handler to be called, with the object's
+	// cache key, whenever the informer observes the object being deleted.
+	// It returns a cancel function that unregisters handler; callers that
+	// only care about deletions for a limited time (e.g. while waiting on
+	// a specific object) should defer cancel() once they stop waiting.
+	AddDeleteHandler(handler func(key string)) (cancel func())
 }
 
 type errWrongType struct {
@@ -77,9 +84,9 @@ func (e *errObjectName) Error() string {
 }
 
 // assumeCache stores two pointers to represent a single object:
-// * The pointer to the informer object.
-// * The pointer to the latest object, which could be the same as
-//   the informer object, or an in-memory object.
+//   - The pointer to the informer object.
+//   - The pointer to the latest object, which could be the same as
+//     the informer object, or an in-memory object.
 //
 // An informer update always overrides the latest object pointer.
 //
@@ -99,6 +106,14 @@ type assumeCache struct {
 	// Index function for object
 	indexFunc cache.IndexFunc
 	indexName string
+
+	// Synchronizes updates to deleteHandlers
+	deleteHandlersMutex sync.Mutex
+
+	// Registered by AddDeleteHandler, keyed by an ID private to this cache
+	// so a handler can be removed again without comparing func values.
+	deleteHandlers      map[int]func(key string)
+	nextDeleteHandlerID int
 }
 
 type objInfo struct {
@@ -131,9 +146,10 @@ func (c *assumeCache) objInfoIndexFunc(obj interface{}) ([]string, error) {
 // NewAssumeCache creates an assume cache for general objects.
 func NewAssumeCache(informer cache.SharedIndexInformer, description, indexName string, indexFunc cache.IndexFunc) AssumeCache {
 	c := &assumeCache{
-		description: description,
-		indexFunc:   indexFunc,
-		indexName:   indexName,
+		description:    description,
+		indexFunc:      indexFunc,
+		indexName:      indexName,
+		deleteHandlers: map[int]func(key string){},
 	}
 	indexers := cache.Indexers{}
 	if indexName != "" && indexFunc != nil {
@@ -212,13 +228,49 @@ func (c *assumeCache) delete(obj interface{}) {
 		return
 	}
 
-	c.rwMutex.Lock()
-	defer c.rwMutex.Unlock()
+	func() {
+		c.rwMutex.Lock()
+		defer c.rwMutex.Unlock()
 
-	objInfo := &objInfo{name: name}
-	err = c.store.Delete(objInfo)
-	if err != nil {
-		klog.Errorf("delete: failed to delete %v %v: %v", c.description, name, err)
+		objInfo := &objInfo{name: name}
+		if err := c.store.Delete(objInfo); err != nil {
+			klog.Errorf("delete: failed to delete %v %v: %v", c.description, name, err)
+		}
+	}()
+
+	c.notifyDeleteHandlers(name)
+}
+
+// AddDeleteHandler implements AssumeCache.
+func (c *assumeCache) AddDeleteHandler(handler func(key string)) (cancel func()) {
+	c.deleteHandlersMutex.Lock()
+	defer c.deleteHandlersMutex.Unlock()
+
+	id := c.nextDeleteHandlerID
+	c.nextDeleteHandlerID++
+	c.deleteHandlers[id] = handler
+
+	return func() {
+		c.deleteHandlersMutex.Lock()
+		defer c.deleteHandlersMutex.Unlock()
+		delete(c.deleteHandlers, id)
+	}
+}
+
+// notifyDeleteHandlers runs the handlers registered via AddDeleteHandler for
+// name. It is called with no cache locks held: a handler may itself call
+// back into the cache (e.g. Get, or AddDeleteHandler/cancel), and the store
+// lock has already done its job of recording the deletion by this point.
+func (c *assumeCache) notifyDeleteHandlers(name string) {
+	c.deleteHandlersMutex.Lock()
+	handlers := make([]func(key string), 0, len(c.deleteHandlers))
+	for _, handler := range c.deleteHandlers {
+		handlers = append(handlers, handler)
+	}
+	c.deleteHandlersMutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(name)
 	}
 }
 
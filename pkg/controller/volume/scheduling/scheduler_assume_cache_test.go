@@ -18,6 +18,7 @@ package scheduling
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"k8s.io/api/core/v1"
@@ -471,3 +472,33 @@ func TestAssumeUpdatePVCCache(t *testing.T) {
 		t.Fatalf("failed to get PVC after old PVC added: %v", err)
 	}
 }
+
+func TestAssumeCacheDeleteHandler(t *testing.T) {
+	cache := NewPVCAssumeCache(nil)
+	internalCache, ok := cache.(*pvcAssumeCache).AssumeCache.(*assumeCache)
+	if !ok {
+		t.Fatalf("Failed to get internal cache")
+	}
+
+	pvc := makeClaim("pvc1", "1", "ns1")
+	internalCache.add(pvc)
+
+	var notified []string
+	cancel := cache.AddDeleteHandler(func(key string) {
+		notified = append(notified, key)
+	})
+
+	internalCache.delete(pvc)
+	want := []string{getPVCName(pvc)}
+	if !reflect.DeepEqual(want, notified) {
+		t.Errorf("got notified %v, want %v", notified, want)
+	}
+
+	// After cancel, deleting again should not notify.
+	cancel()
+	internalCache.add(pvc)
+	internalCache.delete(pvc)
+	if len(notified) != 1 {
+		t.Errorf("handler ran after cancel: got %v", notified)
+	}
+}
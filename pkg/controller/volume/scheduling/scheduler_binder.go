@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -29,6 +31,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/storage/etcd3"
@@ -62,6 +65,18 @@ func (reasons ConflictReasons) Len() int           { return len(reasons) }
 func (reasons ConflictReasons) Less(i, j int) bool { return reasons[i] < reasons[j] }
 func (reasons ConflictReasons) Swap(i, j int)      { reasons[i], reasons[j] = reasons[j], reasons[i] }
 
+// Resolvable reports whether preempting some other pod could plausibly turn
+// reason into a successful placement on the node. ErrReasonBindConflict is
+// the only case today: it covers a PVC that found no available PV because
+// every matching PV is prebound, including ones only provisionally held in
+// the assume cache for another pod that hasn't bound yet. Evicting that pod
+// reverts its assumed bindings and frees the PV back up. The other reasons
+// reflect properties of the PVs/PVCs or node themselves, which preemption
+// cannot change.
+func (reason ConflictReason) Resolvable() bool {
+	return reason == ErrReasonBindConflict
+}
+
 const (
 	// ErrReasonBindConflict is used for VolumeBindingNoMatch predicate error.
 	ErrReasonBindConflict ConflictReason = "node(s) didn't find available persistent volumes to bind"
@@ -87,6 +102,16 @@ func (b *BindingInfo) StorageClassName() string {
 	return b.pv.Spec.StorageClassName
 }
 
+// PVC returns the PVC half of the binding.
+func (b *BindingInfo) PVC() *v1.PersistentVolumeClaim {
+	return b.pvc
+}
+
+// PVName returns the name of the PV half of the binding.
+func (b *BindingInfo) PVName() string {
+	return b.pv.Name
+}
+
 // StorageResource represents storage resource.
 type StorageResource struct {
 	Requested int64
@@ -127,26 +152,26 @@ type InTreeToCSITranslator interface {
 // also considered along with the pod's other scheduling requirements.
 //
 // This integrates into the existing scheduler workflow as follows:
-// 1. The scheduler takes a Pod off the scheduler queue and processes it serially:
-//    a. Invokes all pre-filter plugins for the pod. GetPodVolumes() is invoked
-//    here, pod volume information will be saved in current scheduling cycle state for later use.
-//    b. Invokes all filter plugins, parallelized across nodes.  FindPodVolumes() is invoked here.
-//    c. Invokes all score plugins.  Future/TBD
-//    d. Selects the best node for the Pod.
-//    e. Invokes all reserve plugins. AssumePodVolumes() is invoked here.
-//       i.  If PVC binding is required, cache in-memory only:
-//           * For manual binding: update PV objects for prebinding to the corresponding PVCs.
-//           * For dynamic provisioning: update PVC object with a selected node from c)
-//           * For the pod, which PVCs and PVs need API updates.
-//       ii. Afterwards, the main scheduler caches the Pod->Node binding in the scheduler's pod cache,
-//           This is handled in the scheduler and not here.
-//    f. Asynchronously bind volumes and pod in a separate goroutine
-//        i.  BindPodVolumes() is called first in PreBind phase. It makes all the necessary API updates and waits for
-//            PV controller to fully bind and provision the PVCs. If binding fails, the Pod is sent
-//            back through the scheduler.
-//        ii. After BindPodVolumes() is complete, then the scheduler does the final Pod->Node binding.
-// 2. Once all the assume operations are done in e), the scheduler processes the next Pod in the scheduler queue
-//    while the actual binding operation occurs in the background.
+//  1. The scheduler takes a Pod off the scheduler queue and processes it serially:
+//     a. Invokes all pre-filter plugins for the pod. GetPodVolumes() is invoked
+//     here, pod volume information will be saved in current scheduling cycle state for later use.
+//     b. Invokes all filter plugins, parallelized across nodes.  FindPodVolumes() is invoked here.
+//     c. Invokes all score plugins.  Future/TBD
+//     d. Selects the best node for the Pod.
+//     e. Invokes all reserve plugins. AssumePodVolumes() is invoked here.
+//     i.  If PVC binding is required, cache in-memory only:
+//     * For manual binding: update PV objects for prebinding to the corresponding PVCs.
+//     * For dynamic provisioning: update PVC object with a selected node from c)
+//     * For the pod, which PVCs and PVs need API updates.
+//     ii. Afterwards, the main scheduler caches the Pod->Node binding in the scheduler's pod cache,
+//     This is handled in the scheduler and not here.
+//     f. Asynchronously bind volumes and pod in a separate goroutine
+//     i.  BindPodVolumes() is called first in PreBind phase. It makes all the necessary API updates and waits for
+//     PV controller to fully bind and provision the PVCs. If binding fails, the Pod is sent
+//     back through the scheduler.
+//     ii. After BindPodVolumes() is complete, then the scheduler does the final Pod->Node binding.
+//  2. Once all the assume operations are done in e), the scheduler processes the next Pod in the scheduler queue
+//     while the actual binding operation occurs in the background.
 type SchedulerVolumeBinder interface {
 	// GetPodVolumes returns a pod's PVCs separated into bound, unbound with delayed binding (including provisioning)
 	// and unbound with immediate binding (including prebound)
@@ -190,6 +215,35 @@ type SchedulerVolumeBinder interface {
 	//
 	// This function can be called in parallel.
 	BindPodVolumes(assumedPod *v1.Pod, podVolumes *PodVolumes) error
+
+	// AssumePodVolumesGroup does AssumePodVolumes for every member of group,
+	// as a single atomic step: if any member fails to be assumed, every
+	// member assumed so far in this call is reverted before returning the
+	// error. This lets a gang/coscheduling plugin reserve volumes for an
+	// entire pod group and be sure that a placement failure for one member
+	// never leaves the other members holding a partial volume reservation.
+	//
+	// This function is called serially.
+	AssumePodVolumesGroup(group []GroupPodVolumes) error
+
+	// RevertAssumedPodVolumesGroup reverts every member of a group
+	// previously assumed with AssumePodVolumesGroup.
+	RevertAssumedPodVolumesGroup(group []GroupPodVolumes)
+
+	// SetBindTimeout changes how long BindPodVolumes waits for the PV
+	// controller to finish binding before giving up. It's safe to call while
+	// binds are in flight, so a plugin can apply a new value from updated
+	// config without restarting the scheduler.
+	SetBindTimeout(timeout time.Duration)
+}
+
+// GroupPodVolumes pairs a pod, the node it is tentatively scheduled to, and
+// its computed PodVolumes, for use with AssumePodVolumesGroup and
+// RevertAssumedPodVolumesGroup.
+type GroupPodVolumes struct {
+	Pod        *v1.Pod
+	NodeName   string
+	PodVolumes *PodVolumes
 }
 
 type volumeBinder struct {
@@ -203,14 +257,33 @@ type volumeBinder struct {
 	pvcCache PVCAssumeCache
 	pvCache  PVAssumeCache
 
-	// Amount of time to wait for the bind operation to succeed
-	bindTimeout time.Duration
+	// pvListSnapshots holds per-pod, per-storage-class PV list snapshots
+	// keyed by pod UID. See pvListSnapshot.
+	pvListSnapshots sync.Map
+
+	// Amount of time to wait for the bind operation to succeed, in
+	// nanoseconds. Accessed atomically so SetBindTimeout can be called while
+	// binds started with the previous value are still in flight.
+	bindTimeoutNanos int64
 
 	translator InTreeToCSITranslator
 
 	capacityCheckEnabled     bool
 	csiDriverLister          storagelisters.CSIDriverLister
 	csiStorageCapacityLister storagelistersv1beta1.CSIStorageCapacityLister
+
+	// crossSchedulerReservation, when non-nil, marks assumed PVs with a
+	// reservation annotation that other scheduler instances can observe, so
+	// that FindPodVolumes on those instances can steer away from them. See
+	// CrossSchedulerReservation.
+	crossSchedulerReservation *CrossSchedulerReservation
+
+	// capacityReservationPublisher, when non-nil, makes the binder publish a
+	// ConfigMap recording pending dynamic-provisioning demand once
+	// BindPodVolumes makes its API update to trigger provisioning, so
+	// capacity planners can see the demand before the PV actually exists.
+	// See CapacityReservationPublisher.
+	capacityReservationPublisher *CapacityReservationPublisher
 }
 
 // CapacityCheck contains additional parameters for NewVolumeBinder that
@@ -221,9 +294,39 @@ type CapacityCheck struct {
 	CSIStorageCapacityInformer storageinformersv1beta1.CSIStorageCapacityInformer
 }
 
+// CrossSchedulerReservation contains additional parameters for
+// NewVolumeBinder that are only needed when PV reservations should be made
+// visible to other scheduler instances (other profiles in this process, or a
+// second scheduler instance entirely) before the real bind has landed. Every
+// instance sharing PVs must be configured with the same TTL to agree on when
+// a reservation goes stale.
+type CrossSchedulerReservation struct {
+	// SchedulerID identifies this scheduler instance in the AnnReservedBy
+	// annotation it writes. It only needs to be unique among the scheduler
+	// instances sharing PVs; it is never parsed.
+	SchedulerID string
+	// TTL bounds how long a reservation is honored by other instances if it
+	// is never explicitly released, e.g. because this instance crashed
+	// between assuming and binding.
+	TTL time.Duration
+}
+
+// CapacityReservationPublisher contains additional parameters for
+// NewVolumeBinder that are only needed when pending dynamic-provisioning
+// demand should be published for external capacity planners (cluster
+// autoscalers, capacity dashboards) to observe before the PV exists.
+//
+// It has no fields of its own today; its presence (non-nil) is what enables
+// the feature, following the same convention as CapacityCheck and
+// CrossSchedulerReservation.
+type CapacityReservationPublisher struct {
+}
+
 // NewVolumeBinder sets up all the caches needed for the scheduler to make volume binding decisions.
 //
 // capacityCheck determines whether storage capacity is checked (CSIStorageCapacity feature).
+// crossSchedulerReservation, if non-nil, makes assumed PVs visible to other scheduler instances.
+// capacityReservationPublisher, if non-nil, publishes pending dynamic-provisioning demand for external capacity planners.
 func NewVolumeBinder(
 	kubeClient clientset.Interface,
 	podInformer coreinformers.PodInformer,
@@ -233,17 +336,21 @@ func NewVolumeBinder(
 	pvInformer coreinformers.PersistentVolumeInformer,
 	storageClassInformer storageinformers.StorageClassInformer,
 	capacityCheck *CapacityCheck,
+	crossSchedulerReservation *CrossSchedulerReservation,
+	capacityReservationPublisher *CapacityReservationPublisher,
 	bindTimeout time.Duration) SchedulerVolumeBinder {
 	b := &volumeBinder{
-		kubeClient:    kubeClient,
-		podLister:     podInformer.Lister(),
-		classLister:   storageClassInformer.Lister(),
-		nodeLister:    nodeInformer.Lister(),
-		csiNodeLister: csiNodeInformer.Lister(),
-		pvcCache:      NewPVCAssumeCache(pvcInformer.Informer()),
-		pvCache:       NewPVAssumeCache(pvInformer.Informer()),
-		bindTimeout:   bindTimeout,
-		translator:    csitrans.New(),
+		kubeClient:                   kubeClient,
+		podLister:                    podInformer.Lister(),
+		classLister:                  storageClassInformer.Lister(),
+		nodeLister:                   nodeInformer.Lister(),
+		csiNodeLister:                csiNodeInformer.Lister(),
+		pvcCache:                     NewPVCAssumeCache(pvcInformer.Informer()),
+		pvCache:                      NewPVAssumeCache(pvInformer.Informer()),
+		bindTimeoutNanos:             int64(bindTimeout),
+		translator:                   csitrans.New(),
+		crossSchedulerReservation:    crossSchedulerReservation,
+		capacityReservationPublisher: capacityReservationPublisher,
 	}
 
 	if capacityCheck != nil {
@@ -443,6 +550,55 @@ func (b *volumeBinder) RevertAssumedPodVolumes(podVolumes *PodVolumes) {
 	b.revertAssumedPVCs(podVolumes.DynamicProvisions)
 }
 
+// AssumePodVolumesGroup implements SchedulerVolumeBinder.AssumePodVolumesGroup.
+func (b *volumeBinder) AssumePodVolumesGroup(group []GroupPodVolumes) error {
+	assumed := make([]GroupPodVolumes, 0, len(group))
+	for _, member := range group {
+		if member.PodVolumes == nil {
+			continue
+		}
+		if _, err := b.AssumePodVolumes(member.Pod, member.NodeName, member.PodVolumes); err != nil {
+			b.RevertAssumedPodVolumesGroup(assumed)
+			return fmt.Errorf("assuming volumes for pod %q in group: %v", klog.KObj(member.Pod), err)
+		}
+		assumed = append(assumed, member)
+	}
+	return nil
+}
+
+// RevertAssumedPodVolumesGroup implements SchedulerVolumeBinder.RevertAssumedPodVolumesGroup.
+func (b *volumeBinder) RevertAssumedPodVolumesGroup(group []GroupPodVolumes) {
+	for _, member := range group {
+		if member.PodVolumes == nil {
+			continue
+		}
+		b.RevertAssumedPodVolumes(member.PodVolumes)
+	}
+}
+
+// SetBindTimeout implements SchedulerVolumeBinder.SetBindTimeout.
+func (b *volumeBinder) SetBindTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&b.bindTimeoutNanos, int64(timeout))
+}
+
+// bindTimeout returns the amount of time BindPodVolumes should wait for the
+// PV controller to finish binding before giving up.
+func (b *volumeBinder) bindTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64(&b.bindTimeoutNanos))
+}
+
+// ClaimDeletedError is returned by BindPodVolumes when a PVC it is waiting to
+// bind gets deleted out from under it. Callers should treat this as
+// unresolvable for the current node rather than retrying: the claim it was
+// bound to is gone, so waiting out the rest of the bind timeout cannot help.
+type ClaimDeletedError struct {
+	ClaimKey string
+}
+
+func (e *ClaimDeletedError) Error() string {
+	return fmt.Sprintf("PVC %q was deleted while waiting for binding to complete", e.ClaimKey)
+}
+
 // BindPodVolumes gets the cached bindings and PVCs to provision in pod's volumes information,
 // makes the API update for those PVs/PVCs, and waits for the PVCs to be completely bound
 // by the PV controller.
@@ -467,14 +623,47 @@ func (b *volumeBinder) BindPodVolumes(assumedPod *v1.Pod, podVolumes *PodVolumes
 		return err
 	}
 
-	err = wait.Poll(time.Second, b.bindTimeout, func() (bool, error) {
-		b, err := b.checkBindings(assumedPod, bindings, claimsToProvision)
-		return b, err
+	// Watch for the deletion of any claim we're waiting on, so that a user
+	// deleting a pending PVC aborts the wait immediately instead of burning
+	// the full bind timeout.
+	claimKeys := sets.NewString()
+	for _, binding := range bindings {
+		claimKeys.Insert(getPVCName(binding.pvc))
+	}
+	for _, claim := range claimsToProvision {
+		claimKeys.Insert(getPVCName(claim))
+	}
+	claimDeleted := make(chan string, 1)
+	cancel := b.pvcCache.AddDeleteHandler(func(key string) {
+		if !claimKeys.Has(key) {
+			return
+		}
+		select {
+		case claimDeleted <- key:
+		default:
+		}
 	})
-	if err != nil {
-		return fmt.Errorf("binding volumes: %w", err)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.After(b.bindTimeout())
+	for {
+		select {
+		case key := <-claimDeleted:
+			return fmt.Errorf("binding volumes: %w", &ClaimDeletedError{ClaimKey: key})
+		case <-timeout:
+			return fmt.Errorf("binding volumes: %w", wait.ErrWaitTimeout)
+		case <-ticker.C:
+			done, err := b.checkBindings(assumedPod, bindings, claimsToProvision)
+			if err != nil {
+				return fmt.Errorf("binding volumes: %w", err)
+			}
+			if done {
+				return nil
+			}
+		}
 	}
-	return nil
 }
 
 func getPodName(pod *v1.Pod) string {
@@ -519,12 +708,25 @@ func (b *volumeBinder) bindAPIUpdate(podName string, bindings []*BindingInfo, cl
 		klog.V(5).Infof("bindAPIUpdate: Pod %q, binding PV %q to PVC %q", podName, binding.pv.Name, binding.pvc.Name)
 		// TODO: does it hurt if we make an api call and nothing needs to be updated?
 		claimKey := getPVCName(binding.pvc)
-		klog.V(2).Infof("claim %q bound to volume %q", claimKey, binding.pv.Name)
+		if b.crossSchedulerReservation != nil {
+			// Best-effort: a failure to publish the reservation doesn't
+			// fail the bind, it just means other scheduler instances won't
+			// see it until the real bind below lands. Done here, on the
+			// async per-pod binding cycle, rather than when the PV is
+			// first assumed: that happens synchronously in the main
+			// scheduling loop, and a slow or unreachable API server must
+			// not stall it.
+			b.reservePV(binding.pv)
+		}
 		newPV, err := b.kubeClient.CoreV1().PersistentVolumes().Update(context.TODO(), binding.pv, metav1.UpdateOptions{})
 		if err != nil {
 			klog.V(4).Infof("updating PersistentVolume[%s]: binding to %q failed: %v", binding.pv.Name, claimKey, err)
+			if b.crossSchedulerReservation != nil {
+				b.releasePV(binding.pv)
+			}
 			return err
 		}
+		klog.V(2).Infof("claim %q bound to volume %q", claimKey, binding.pv.Name)
 		klog.V(4).Infof("updating PersistentVolume[%s]: bound to %q", binding.pv.Name, claimKey)
 		// Save updated object from apiserver for later checking.
 		binding.pv = newPV
@@ -542,6 +744,17 @@ func (b *volumeBinder) bindAPIUpdate(podName string, bindings []*BindingInfo, cl
 		// Save updated object from apiserver for later checking.
 		claimsToProvision[i] = newClaim
 		lastProcessedProvisioning++
+
+		if b.capacityReservationPublisher != nil {
+			// Best-effort: a failure to publish leaves capacity planners
+			// blind to this one claim's demand until the real PV shows up,
+			// it does not fail the bind. Done here, alongside the other
+			// blocking API calls BindPodVolumes already makes, rather than
+			// from AssumePodVolumes: AssumePodVolumes runs synchronously in
+			// the main scheduling loop, while BindPodVolumes already runs
+			// off the async per-pod binding cycle.
+			b.publishCapacityReservation(newClaim, newClaim.Annotations[pvutil.AnnSelectedNode])
+		}
 	}
 
 	return nil
@@ -655,6 +868,13 @@ func (b *volumeBinder) checkBindings(pod *v1.Pod, bindings []*BindingInfo, claim
 			return false, fmt.Errorf("provisioning failed for PVC %q", pvc.Name)
 		}
 
+		// The PV controller sets this once it has finished committing this
+		// exact bind; trust that explicit signal instead of re-deriving
+		// completion from the PV's node affinity below.
+		if metav1.HasAnnotation(pvc.ObjectMeta, pvutil.AnnSchedulerBindCompleted) {
+			continue
+		}
+
 		// If the PVC is bound to a PV, check its node affinity
 		if pvc.Spec.VolumeName != "" {
 			pv, err := b.pvCache.GetAPIPV(pvc.Spec.VolumeName)
@@ -793,9 +1013,71 @@ func (b *volumeBinder) GetPodVolumes(pod *v1.Pod) (boundClaims []*v1.PersistentV
 			}
 		}
 	}
+	if len(unboundClaimsDelayBinding) > 0 {
+		b.snapshotPVsForPod(pod, unboundClaimsDelayBinding)
+	}
 	return boundClaims, unboundClaimsDelayBinding, unboundClaimsImmediate, nil
 }
 
+// pvListSnapshotTTL bounds how long a cached per-pod PV-list snapshot is
+// reused before findMatchingVolumes falls back to a live list. It only needs
+// to outlast a single scheduling cycle; an entry still here after that was
+// abandoned (e.g. the pod was deleted before it could be scheduled) rather
+// than naturally replaced by this pod's next scheduling attempt.
+const pvListSnapshotTTL = 60 * time.Second
+
+// pvListSnapshot freezes, per storage class, the PVs seen when a pod's
+// scheduling cycle started. Filter runs concurrently across nodes for the
+// same pod, and without this, two nodes can see different results from
+// b.pvCache.ListPVs if another pod is assumed in between, producing
+// inconsistent Filter decisions within a single cycle.
+type pvListSnapshot struct {
+	byClass map[string][]*v1.PersistentVolume
+	expires time.Time
+}
+
+// snapshotPVsForPod records the current PVs for every storage class among
+// claims, so that findMatchingVolumes can use a single consistent view of
+// them for every node visited while scheduling pod.
+func (b *volumeBinder) snapshotPVsForPod(pod *v1.Pod, claims []*v1.PersistentVolumeClaim) {
+	snapshot := &pvListSnapshot{
+		byClass: make(map[string][]*v1.PersistentVolume),
+		expires: time.Now().Add(pvListSnapshotTTL),
+	}
+	for _, claim := range claims {
+		className := storagehelpers.GetPersistentVolumeClaimClass(claim)
+		if _, ok := snapshot.byClass[className]; ok {
+			continue
+		}
+		snapshot.byClass[className] = b.pvCache.ListPVs(className)
+	}
+	b.pvListSnapshots.Store(pod.UID, snapshot)
+
+	// Opportunistically bound memory by dropping other pods' expired snapshots.
+	now := time.Now()
+	b.pvListSnapshots.Range(func(key, value interface{}) bool {
+		if key != pod.UID && now.After(value.(*pvListSnapshot).expires) {
+			b.pvListSnapshots.Delete(key)
+		}
+		return true
+	})
+}
+
+// pvsForClass returns the PVs for storageClassName, preferring the snapshot
+// taken for pod at the start of its current scheduling cycle (see
+// snapshotPVsForPod) and falling back to a live list if none was taken, or
+// it expired, e.g. because FindPodVolumes was called directly without going
+// through GetPodVolumes first.
+func (b *volumeBinder) pvsForClass(pod *v1.Pod, storageClassName string) []*v1.PersistentVolume {
+	if v, ok := b.pvListSnapshots.Load(pod.UID); ok {
+		snapshot := v.(*pvListSnapshot)
+		if time.Now().Before(snapshot.expires) {
+			return snapshot.byClass[storageClassName]
+		}
+	}
+	return b.pvCache.ListPVs(storageClassName)
+}
+
 func (b *volumeBinder) checkBoundClaims(claims []*v1.PersistentVolumeClaim, node *v1.Node, podName string) (bool, bool, error) {
 	csiNode, err := b.csiNodeLister.Get(node.Name)
 	if err != nil {
@@ -844,7 +1126,10 @@ func (b *volumeBinder) findMatchingVolumes(pod *v1.Pod, claimsToBind []*v1.Persi
 	for _, pvc := range claimsToBind {
 		// Get storage class name from each PVC
 		storageClassName := storagehelpers.GetPersistentVolumeClaimClass(pvc)
-		allPVs := b.pvCache.ListPVs(storageClassName)
+		allPVs := b.pvsForClass(pod, storageClassName)
+		if b.crossSchedulerReservation != nil {
+			allPVs = filterReservedByOtherScheduler(allPVs, b.crossSchedulerReservation.SchedulerID)
+		}
 		pvcName := getPVCName(pvc)
 
 		// Find a matching PV
@@ -928,6 +1213,141 @@ func (b *volumeBinder) revertAssumedPVs(bindings []*BindingInfo) {
 	}
 }
 
+// capacityReservationLabel marks ConfigMaps written by
+// publishCapacityReservation, so capacity planners can discover them with a
+// label selector instead of having to know individual names in advance.
+const capacityReservationLabel = "volume.kubernetes.io/capacity-reservation"
+
+// capacityReservationPublishTimeout bounds the ConfigMap API calls
+// publishCapacityReservation makes, since it runs from BindPodVolumes on
+// the async per-pod binding goroutine and must not hang that goroutine
+// indefinitely on a slow or unreachable API server.
+const capacityReservationPublishTimeout = 5 * time.Second
+
+// publishCapacityReservation records pending dynamic-provisioning demand for
+// claim on nodeName in a well-known ConfigMap, so cluster autoscalers and
+// capacity dashboards can see the demand before the real PV exists. The
+// ConfigMap is owned by claim, so it is garbage collected once the claim is
+// deleted; a planner should still check the claim's phase, since the
+// ConfigMap is not removed just because the claim gets bound. It is advisory
+// only: a failure here is logged and otherwise ignored, since nothing in the
+// scheduling path depends on it.
+func (b *volumeBinder) publishCapacityReservation(claim *v1.PersistentVolumeClaim, nodeName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), capacityReservationPublishTimeout)
+	defer cancel()
+
+	name := claim.Name + "-capacity-reservation"
+	requested := claim.Spec.Resources.Requests[v1.ResourceStorage]
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: claim.Namespace,
+			Labels:    map[string]string{capacityReservationLabel: "true"},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(claim, v1.SchemeGroupVersion.WithKind("PersistentVolumeClaim")),
+			},
+		},
+		Data: map[string]string{
+			"claim":             claim.Name,
+			"node":              nodeName,
+			"storageClassName":  storagehelpers.GetPersistentVolumeClaimClass(claim),
+			"requestedCapacity": requested.String(),
+			"requestedAt":       time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+
+	cms := b.kubeClient.CoreV1().ConfigMaps(claim.Namespace)
+	if _, err := cms.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			klog.V(4).Infof("publishCapacityReservation: failed to create capacity reservation for claim %q: %v", getPVCName(claim), err)
+			return
+		}
+		existing, err := cms.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			klog.V(4).Infof("publishCapacityReservation: failed to get existing capacity reservation for claim %q: %v", getPVCName(claim), err)
+			return
+		}
+		cm.ResourceVersion = existing.ResourceVersion
+		if _, err := cms.Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+			klog.V(4).Infof("publishCapacityReservation: failed to update capacity reservation for claim %q: %v", getPVCName(claim), err)
+		}
+	}
+}
+
+// reservationPatchTimeout bounds the PersistentVolume annotation Patch calls
+// made by reservePV and releasePV, since both run from bindAPIUpdate on the
+// async per-pod binding goroutine and must not hang that goroutine
+// indefinitely on a slow or unreachable API server.
+const reservationPatchTimeout = 5 * time.Second
+
+// reservePV publishes this scheduler instance's claim on pv as an annotation
+// so that other scheduler instances sharing the same PVs can see the
+// reservation before the real bind has landed, and steer away from pv in
+// findMatchingVolumes. It is advisory only: a failure here is logged and
+// otherwise ignored, since the local assume cache remains authoritative for
+// this scheduler instance regardless.
+func (b *volumeBinder) reservePV(pv *v1.PersistentVolume) {
+	ctx, cancel := context.WithTimeout(context.Background(), reservationPatchTimeout)
+	defer cancel()
+
+	r := b.crossSchedulerReservation
+	expiry := time.Now().Add(r.TTL).UTC().Format(time.RFC3339)
+	patch := []byte(fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:%q,%q:%q}}}`,
+		pvutil.AnnReservedBy, r.SchedulerID, pvutil.AnnReservationExpiry, expiry))
+	if _, err := b.kubeClient.CoreV1().PersistentVolumes().Patch(ctx, pv.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.V(4).Infof("reservePV: failed to annotate PV %q with reservation by %q: %v", pv.Name, r.SchedulerID, err)
+	}
+}
+
+// releasePV clears a reservation previously published by reservePV. Like
+// reservePV, it is advisory only; a reservation that can't be cleared (e.g.
+// because this scheduler instance lost connectivity) simply expires on its
+// own once its TTL passes.
+func (b *volumeBinder) releasePV(pv *v1.PersistentVolume) {
+	ctx, cancel := context.WithTimeout(context.Background(), reservationPatchTimeout)
+	defer cancel()
+
+	patch := []byte(fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:null,%q:null}}}`,
+		pvutil.AnnReservedBy, pvutil.AnnReservationExpiry))
+	if _, err := b.kubeClient.CoreV1().PersistentVolumes().Patch(ctx, pv.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.V(4).Infof("releasePV: failed to clear reservation on PV %q: %v", pv.Name, err)
+	}
+}
+
+// isReservedByOther reports whether pv carries an unexpired reservation
+// annotation written by a different scheduler instance.
+func isReservedByOther(pv *v1.PersistentVolume, schedulerID string) bool {
+	reservedBy, ok := pv.Annotations[pvutil.AnnReservedBy]
+	if !ok || reservedBy == schedulerID {
+		return false
+	}
+	expiry, ok := pv.Annotations[pvutil.AnnReservationExpiry]
+	if !ok {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return false
+	}
+	return time.Now().Before(t)
+}
+
+// filterReservedByOtherScheduler drops PVs from pvs that another scheduler
+// instance has reserved, so that findMatchingVolumes doesn't try to assume a
+// PV that's already provisionally spoken for elsewhere.
+func filterReservedByOtherScheduler(pvs []*v1.PersistentVolume, schedulerID string) []*v1.PersistentVolume {
+	filtered := make([]*v1.PersistentVolume, 0, len(pvs))
+	for _, pv := range pvs {
+		if isReservedByOther(pv, schedulerID) {
+			continue
+		}
+		filtered = append(filtered, pv)
+	}
+	return filtered
+}
+
 func (b *volumeBinder) revertAssumedPVCs(claims []*v1.PersistentVolumeClaim) {
 	for _, claim := range claims {
 		b.pvcCache.Restore(getPVCName(claim))
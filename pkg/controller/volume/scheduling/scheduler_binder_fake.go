@@ -16,7 +16,11 @@ limitations under the License.
 
 package scheduling
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
 
 // FakeVolumeBinderConfig holds configurations for fake volume binder.
 type FakeVolumeBinderConfig struct {
@@ -40,6 +44,7 @@ type FakeVolumeBinder struct {
 	config       *FakeVolumeBinderConfig
 	AssumeCalled bool
 	BindCalled   bool
+	BindTimeout  time.Duration
 }
 
 // GetPodVolumes implements SchedulerVolumeBinder.GetPodVolumes.
@@ -66,3 +71,31 @@ func (b *FakeVolumeBinder) BindPodVolumes(assumedPod *v1.Pod, podVolumes *PodVol
 	b.BindCalled = true
 	return b.config.BindErr
 }
+
+// AssumePodVolumesGroup implements SchedulerVolumeBinder.AssumePodVolumesGroup.
+func (b *FakeVolumeBinder) AssumePodVolumesGroup(group []GroupPodVolumes) error {
+	for _, member := range group {
+		if member.PodVolumes == nil {
+			continue
+		}
+		if _, err := b.AssumePodVolumes(member.Pod, member.NodeName, member.PodVolumes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevertAssumedPodVolumesGroup implements SchedulerVolumeBinder.RevertAssumedPodVolumesGroup.
+func (b *FakeVolumeBinder) RevertAssumedPodVolumesGroup(group []GroupPodVolumes) {
+	for _, member := range group {
+		if member.PodVolumes == nil {
+			continue
+		}
+		b.RevertAssumedPodVolumes(member.PodVolumes)
+	}
+}
+
+// SetBindTimeout implements SchedulerVolumeBinder.SetBindTimeout.
+func (b *FakeVolumeBinder) SetBindTimeout(timeout time.Duration) {
+	b.BindTimeout = timeout
+}
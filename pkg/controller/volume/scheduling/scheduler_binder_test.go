@@ -18,9 +18,11 @@ package scheduling
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -181,6 +183,8 @@ func newTestBinder(t *testing.T, stopCh <-chan struct{}, csiStorageCapacity ...b
 		informerFactory.Core().V1().PersistentVolumes(),
 		classInformer,
 		capacityCheck,
+		nil,
+		nil,
 		10*time.Second)
 
 	// Wait for informers cache sync
@@ -706,6 +710,12 @@ func pvcSetEmptyAnnotations(pvc *v1.PersistentVolumeClaim) *v1.PersistentVolumeC
 	return newPVC
 }
 
+func pvcSetSchedulerBindCompleted(pvc *v1.PersistentVolumeClaim) *v1.PersistentVolumeClaim {
+	newPVC := pvc.DeepCopy()
+	metav1.SetMetaDataAnnotation(&newPVC.ObjectMeta, pvutil.AnnSchedulerBindCompleted, "yes")
+	return newPVC
+}
+
 func pvRemoveClaimUID(pv *v1.PersistentVolume) *v1.PersistentVolume {
 	newPV := pv.DeepCopy()
 	newPV.Spec.ClaimRef.UID = ""
@@ -1462,6 +1472,71 @@ func TestRevertAssumedPodVolumes(t *testing.T) {
 	testEnv.validateCacheRestored(t, pod, bindings, provisionedPVCs)
 }
 
+func TestAssumePodVolumesGroup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	podAPVCs := []*v1.PersistentVolumeClaim{unboundPVC}
+	podABindings := []*BindingInfo{makeBinding(unboundPVC, pvNode1a)}
+	podA := makePod(podAPVCs)
+
+	podBPVCs := []*v1.PersistentVolumeClaim{unboundPVC2}
+	// podB's bindings reference pvNode1b, which is never added to the
+	// binder's PV cache below, so assuming podB always fails.
+	podBBindings := []*BindingInfo{makeBinding(unboundPVC2, pvNode1b)}
+	podB := makePod(podBPVCs)
+
+	testEnv := newTestBinder(t, ctx.Done())
+	testEnv.initClaims(append(podAPVCs, podBPVCs...), append(podAPVCs, podBPVCs...))
+	testEnv.initVolumes([]*v1.PersistentVolume{pvNode1a}, []*v1.PersistentVolume{pvNode1a})
+
+	group := []GroupPodVolumes{
+		{Pod: podA, NodeName: "node1", PodVolumes: &PodVolumes{StaticBindings: podABindings}},
+		{Pod: podB, NodeName: "node1", PodVolumes: &PodVolumes{StaticBindings: podBBindings}},
+	}
+
+	if err := testEnv.binder.AssumePodVolumesGroup(group); err == nil {
+		t.Fatal("expected AssumePodVolumesGroup to fail when one group member cannot be assumed")
+	}
+
+	// podA was assumed successfully before podB failed; the group call must
+	// revert it so no partial reservation survives the failure.
+	testEnv.validateCacheRestored(t, podA, podABindings, nil)
+}
+
+func TestRevertAssumedPodVolumesGroup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	podAPVCs := []*v1.PersistentVolumeClaim{unboundPVC}
+	podABindings := []*BindingInfo{makeBinding(unboundPVC, pvNode1a)}
+	podA := makePod(podAPVCs)
+	podAVolumes := &PodVolumes{StaticBindings: podABindings}
+
+	podBPVCs := []*v1.PersistentVolumeClaim{unboundPVC2}
+	podBBindings := []*BindingInfo{makeBinding(unboundPVC2, pvNode1b)}
+	podB := makePod(podBPVCs)
+	podBVolumes := &PodVolumes{StaticBindings: podBBindings}
+
+	testEnv := newTestBinder(t, ctx.Done())
+	testEnv.initClaims(append(podAPVCs, podBPVCs...), append(podAPVCs, podBPVCs...))
+	testEnv.initVolumes([]*v1.PersistentVolume{pvNode1a, pvNode1b}, []*v1.PersistentVolume{pvNode1a, pvNode1b})
+
+	group := []GroupPodVolumes{
+		{Pod: podA, NodeName: "node1", PodVolumes: podAVolumes},
+		{Pod: podB, NodeName: "node1", PodVolumes: podBVolumes},
+	}
+	if err := testEnv.binder.AssumePodVolumesGroup(group); err != nil {
+		t.Fatalf("AssumePodVolumesGroup failed: %v", err)
+	}
+	testEnv.validateAssume(t, podA, []*BindingInfo{makeBinding(unboundPVC, pvNode1aBound)}, nil)
+	testEnv.validateAssume(t, podB, []*BindingInfo{makeBinding(unboundPVC2, pvNode1bBound)}, nil)
+
+	testEnv.binder.RevertAssumedPodVolumesGroup(group)
+	testEnv.validateCacheRestored(t, podA, podABindings, nil)
+	testEnv.validateCacheRestored(t, podB, podBBindings, nil)
+}
+
 func TestBindAPIUpdate(t *testing.T) {
 	type scenarioType struct {
 		// Inputs
@@ -1729,6 +1804,13 @@ func TestCheckBindings(t *testing.T) {
 			apiPVCs:         []*v1.PersistentVolumeClaim{pvcSetEmptyAnnotations(provisionedPVC)},
 			shouldFail:      true,
 		},
+		"provisioning-pvc-scheduler-bind-completed": {
+			bindings:        []*BindingInfo{},
+			provisionedPVCs: []*v1.PersistentVolumeClaim{addProvisionAnn(provisionedPVC)},
+			initPVCs:        []*v1.PersistentVolumeClaim{addProvisionAnn(provisionedPVC)},
+			apiPVCs:         []*v1.PersistentVolumeClaim{pvcSetSchedulerBindCompleted(addProvisionAnn(provisionedPVC))},
+			expectedBound:   true,
+		},
 		"provisioning-pvc-selected-node-wrong-node": {
 			initPVCs:        []*v1.PersistentVolumeClaim{provisionedPVC},
 			bindings:        []*BindingInfo{},
@@ -2139,6 +2221,48 @@ func TestBindPodVolumes(t *testing.T) {
 	}
 }
 
+func TestBindPodVolumesAbortsOnClaimDeletion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := makePod(nil)
+	testEnv := newTestBinder(t, ctx.Done())
+	testEnv.internalPodInformer.Informer().GetIndexer().Add(pod)
+	testEnv.initNodes([]*v1.Node{node1})
+	testEnv.initVolumes([]*v1.PersistentVolume{pvNode1a}, []*v1.PersistentVolume{pvNode1a})
+	testEnv.initClaims([]*v1.PersistentVolumeClaim{unboundPVC}, []*v1.PersistentVolumeClaim{unboundPVC})
+	binding := makeBinding(unboundPVC, pvNode1aBound)
+	testEnv.assumeVolumes(t, "node1", pod, []*BindingInfo{binding}, nil)
+
+	// Use a long bind timeout: a passing test can then only be explained by
+	// the deletion aborting the wait early, not by it merely timing out.
+	atomic.StoreInt64(&testEnv.internalBinder.bindTimeoutNanos, int64(time.Minute))
+
+	// Simulate the informer observing the claim's deletion, the same way
+	// TestAssumeUpdatePVCCache does: tests in this package don't route
+	// deletes through the fake clientset's watch machinery, since the
+	// VolumeReactor used here never pushes delete events to watchers.
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		testEnv.internalPVCCache.delete(unboundPVC)
+	}()
+
+	start := time.Now()
+	err := testEnv.binder.BindPodVolumes(pod, &PodVolumes{StaticBindings: []*BindingInfo{binding}, DynamicProvisions: []*v1.PersistentVolumeClaim{}})
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error after the claim was deleted")
+	}
+	var claimDeleted *ClaimDeletedError
+	if !errors.As(err, &claimDeleted) {
+		t.Fatalf("got error %v, want a *ClaimDeletedError", err)
+	}
+	if elapsed > 10*time.Second {
+		t.Errorf("BindPodVolumes took %v to notice the deletion, want well under the 1 minute timeout", elapsed)
+	}
+}
+
 func TestFindAssumeVolumes(t *testing.T) {
 	// Test case
 	podPVCs := []*v1.PersistentVolumeClaim{unboundPVC}
@@ -2352,3 +2476,133 @@ func TestCapacity(t *testing.T) {
 		})
 	}
 }
+
+func TestIsReservedByOther(t *testing.T) {
+	withReservation := func(schedulerID string, expiry time.Time) *v1.PersistentVolume {
+		pv := pvNode1a.DeepCopy()
+		metav1.SetMetaDataAnnotation(&pv.ObjectMeta, pvutil.AnnReservedBy, schedulerID)
+		metav1.SetMetaDataAnnotation(&pv.ObjectMeta, pvutil.AnnReservationExpiry, expiry.UTC().Format(time.RFC3339))
+		return pv
+	}
+
+	scenarios := map[string]struct {
+		pv   *v1.PersistentVolume
+		want bool
+	}{
+		"no-reservation":      {pv: pvNode1a, want: false},
+		"reserved-by-self":    {pv: withReservation("scheduler-a", time.Now().Add(time.Minute)), want: false},
+		"reserved-by-other":   {pv: withReservation("scheduler-b", time.Now().Add(time.Minute)), want: true},
+		"reservation-expired": {pv: withReservation("scheduler-b", time.Now().Add(-time.Minute)), want: false},
+		"missing-expiry-is-moot": {pv: func() *v1.PersistentVolume {
+			pv := pvNode1a.DeepCopy()
+			metav1.SetMetaDataAnnotation(&pv.ObjectMeta, pvutil.AnnReservedBy, "scheduler-b")
+			return pv
+		}(), want: false},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			if got := isReservedByOther(scenario.pv, "scheduler-a"); got != scenario.want {
+				t.Errorf("isReservedByOther() = %v, want %v", got, scenario.want)
+			}
+		})
+	}
+}
+
+func TestFilterReservedByOtherScheduler(t *testing.T) {
+	reservedByOther := pvNode1a.DeepCopy()
+	metav1.SetMetaDataAnnotation(&reservedByOther.ObjectMeta, pvutil.AnnReservedBy, "scheduler-b")
+	metav1.SetMetaDataAnnotation(&reservedByOther.ObjectMeta, pvutil.AnnReservationExpiry, time.Now().Add(time.Minute).UTC().Format(time.RFC3339))
+
+	got := filterReservedByOtherScheduler([]*v1.PersistentVolume{pvNode1b, reservedByOther}, "scheduler-a")
+	if len(got) != 1 || got[0].Name != pvNode1b.Name {
+		t.Errorf("filterReservedByOtherScheduler() = %v, want only %q", got, pvNode1b.Name)
+	}
+}
+
+// TestFindPodVolumesUsesSnapshotAcrossNodes verifies that FindPodVolumes
+// calls for the same pod, against different nodes, see a consistent view of
+// the candidate PVs even if the PV cache changes in between -- as it would
+// if another pod's volume got assumed while this pod's Filter was still
+// fanning out across nodes. See pvListSnapshot.
+func TestFindPodVolumesUsesSnapshotAcrossNodes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testEnv := newTestBinder(t, ctx.Done())
+	testEnv.initVolumes([]*v1.PersistentVolume{pvNode1a}, []*v1.PersistentVolume{pvNode1a})
+	testEnv.initClaims([]*v1.PersistentVolumeClaim{unboundPVC}, []*v1.PersistentVolumeClaim{unboundPVC})
+	pod := makePod([]*v1.PersistentVolumeClaim{unboundPVC})
+
+	boundClaims, claimsToBind, unboundClaimsImmediate, err := testEnv.binder.GetPodVolumes(pod)
+	if err != nil {
+		t.Fatalf("GetPodVolumes failed: %v", err)
+	}
+	if len(unboundClaimsImmediate) != 0 {
+		t.Fatalf("expected no immediate unbound claims, got %v", unboundClaimsImmediate)
+	}
+
+	// Simulate another pod's scheduling cycle assuming pvNode1a concurrently,
+	// in between this pod's per-node Filter calls.
+	testEnv.deleteVolumes([]*v1.PersistentVolume{pvNode1a})
+
+	for _, node := range []*v1.Node{node1, node2} {
+		podVolumes, reasons, err := testEnv.binder.FindPodVolumes(pod, boundClaims, claimsToBind, node)
+		if err != nil {
+			t.Fatalf("FindPodVolumes for %q failed: %v", node.Name, err)
+		}
+		switch node.Name {
+		case node1.Name:
+			// pvNode1a only exists in the snapshot taken by GetPodVolumes
+			// before it was removed from the live cache, so node1 -- the
+			// node it's affine to -- should still match it.
+			checkReasons(t, reasons, nil)
+			testEnv.validatePodCache(t, node.Name, pod, podVolumes, []*BindingInfo{makeBinding(unboundPVC, pvNode1a)}, nil)
+		case node2.Name:
+			// node2 was never a match for pvNode1a, snapshot or not.
+			checkReasons(t, reasons, ConflictReasons{ErrReasonBindConflict})
+		}
+	}
+}
+
+// TestPublishCapacityReservation verifies that publishCapacityReservation
+// writes a ConfigMap recording the claim's pending dynamic-provisioning
+// demand, owned by the claim, and that calling it again (as would happen on
+// a scheduling retry) updates that same ConfigMap instead of failing.
+func TestPublishCapacityReservation(t *testing.T) {
+	claim := makeTestPVC("pvc1", "1G", "", pvcUnbound, "", "1", &waitClass)
+	client := fake.NewSimpleClientset(claim)
+	b := &volumeBinder{kubeClient: client}
+
+	b.publishCapacityReservation(claim, "node1")
+
+	cm, err := client.CoreV1().ConfigMaps(claim.Namespace).Get(context.TODO(), claim.Name+"-capacity-reservation", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected capacity reservation ConfigMap to exist: %v", err)
+	}
+	if cm.Labels[capacityReservationLabel] != "true" {
+		t.Errorf("got labels %v, want %q: \"true\"", cm.Labels, capacityReservationLabel)
+	}
+	if len(cm.OwnerReferences) != 1 || cm.OwnerReferences[0].UID != claim.UID {
+		t.Errorf("got owner references %v, want claim %q as sole owner", cm.OwnerReferences, claim.UID)
+	}
+	if cm.Data["node"] != "node1" {
+		t.Errorf("got node %q, want %q", cm.Data["node"], "node1")
+	}
+	if cm.Data["storageClassName"] != waitClass {
+		t.Errorf("got storage class %q, want %q", cm.Data["storageClassName"], waitClass)
+	}
+	if cm.Data["requestedCapacity"] != "1G" {
+		t.Errorf("got requested capacity %q, want %q", cm.Data["requestedCapacity"], "1G")
+	}
+
+	// A retry on a different node should update, not fail to create.
+	b.publishCapacityReservation(claim, "node2")
+	cm, err = client.CoreV1().ConfigMaps(claim.Namespace).Get(context.TODO(), claim.Name+"-capacity-reservation", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected capacity reservation ConfigMap to still exist: %v", err)
+	}
+	if cm.Data["node"] != "node2" {
+		t.Errorf("got node %q after retry, want %q", cm.Data["node"], "node2")
+	}
+}
@@ -622,6 +622,14 @@ const (
 	// Enables policies controlling deletion of PVCs created by a StatefulSet.
 	StatefulSetAutoDeletePVC featuregate.Feature = "StatefulSetAutoDeletePVC"
 
+	// owner: @cofyc
+	// alpha: v1.23
+	//
+	// Lets the StatefulSet controller nominate the node implied by a
+	// recreated pod's already-bound local PV, so the scheduler tries that
+	// node first instead of searching the whole cluster.
+	StatefulSetLocalPVNodeHint featuregate.Feature = "StatefulSetLocalPVNodeHint"
+
 	// owner: @ahg-g
 	// alpha: v1.21
 	// beta: v1.22
@@ -841,6 +849,7 @@ var defaultKubernetesFeatureGates = map[featuregate.Feature]featuregate.FeatureS
 	ProbeTerminationGracePeriod:                    {Default: false, PreRelease: featuregate.Alpha},
 	PodDeletionCost:                                {Default: true, PreRelease: featuregate.Beta},
 	StatefulSetAutoDeletePVC:                       {Default: false, PreRelease: featuregate.Alpha},
+	StatefulSetLocalPVNodeHint:                     {Default: false, PreRelease: featuregate.Alpha},
 	TopologyAwareHints:                             {Default: false, PreRelease: featuregate.Alpha},
 	PodAffinityNamespaceSelector:                   {Default: true, PreRelease: featuregate.Beta},
 	ServiceLoadBalancerClass:                       {Default: true, PreRelease: featuregate.Beta},
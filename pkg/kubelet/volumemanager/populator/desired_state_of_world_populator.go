@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	clientset "k8s.io/client-go/kubernetes"
+	pvutil "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/util"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
@@ -79,6 +80,8 @@ type DesiredStateOfWorldPopulator interface {
 // podManager - the kubelet podManager that is the source of truth for the pods
 //     that exist on this host
 // desiredStateOfWorld - the cache to populate
+// nodeName - the name of this node, used to detect PVCs bound for a
+//     different node than this one (see getPVCExtractPV)
 func NewDesiredStateOfWorldPopulator(
 	kubeClient clientset.Interface,
 	loopSleepDuration time.Duration,
@@ -91,7 +94,8 @@ func NewDesiredStateOfWorldPopulator(
 	keepTerminatedPodVolumes bool,
 	csiMigratedPluginManager csimigration.PluginManager,
 	intreeToCSITranslator csimigration.InTreeToCSITranslator,
-	volumePluginMgr *volume.VolumePluginMgr) DesiredStateOfWorldPopulator {
+	volumePluginMgr *volume.VolumePluginMgr,
+	nodeName types.NodeName) DesiredStateOfWorldPopulator {
 	return &desiredStateOfWorldPopulator{
 		kubeClient:                kubeClient,
 		loopSleepDuration:         loopSleepDuration,
@@ -109,6 +113,7 @@ func NewDesiredStateOfWorldPopulator(
 		csiMigratedPluginManager: csiMigratedPluginManager,
 		intreeToCSITranslator:    intreeToCSITranslator,
 		volumePluginMgr:          volumePluginMgr,
+		nodeName:                 nodeName,
 	}
 }
 
@@ -129,6 +134,10 @@ type desiredStateOfWorldPopulator struct {
 	csiMigratedPluginManager  csimigration.PluginManager
 	intreeToCSITranslator     csimigration.InTreeToCSITranslator
 	volumePluginMgr           *volume.VolumePluginMgr
+	// nodeName is the name of this node, used to verify that a Bound PVC
+	// was actually selected for this node before mounting it. See
+	// getPVCExtractPV.
+	nodeName types.NodeName
 }
 
 type processedPods struct {
@@ -636,6 +645,19 @@ func (dswp *desiredStateOfWorldPopulator) getPVCExtractPV(
 		return nil, errors.New("PVC has empty pvc.Spec.VolumeName")
 	}
 
+	// The scheduler records which node it selected a delay-bound PVC for in
+	// AnnSelectedNode, and that annotation survives a successful bind. If
+	// the scheduler later unreserved this pod (e.g. a race lost to another
+	// pod, or the binding was rolled back) and the PVC went on to be bound
+	// for a different node, mounting it here would use a volume that is
+	// about to be, or already is, in use elsewhere. Catch that before
+	// mounting instead of racing the re-provisioning.
+	if selectedNode, ok := pvc.Annotations[pvutil.AnnSelectedNode]; ok && dswp.nodeName != "" && selectedNode != string(dswp.nodeName) {
+		return nil, fmt.Errorf(
+			"PVC is bound for node %q, not %q; the scheduler may have rolled back and rescheduled this pod elsewhere",
+			selectedNode, dswp.nodeName)
+	}
+
 	return pvc, nil
 }
 
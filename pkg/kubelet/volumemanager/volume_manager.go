@@ -202,7 +202,8 @@ func NewVolumeManager(
 		keepTerminatedPodVolumes,
 		csiMigratedPluginManager,
 		intreeToCSITranslator,
-		volumePluginMgr)
+		volumePluginMgr,
+		nodeName)
 	vm.reconciler = reconciler.NewReconciler(
 		kubeClient,
 		controllerAttachDetachEnabled,
@@ -1121,6 +1121,8 @@ profiles:
 				},
 			},
 			want: `apiVersion: kubescheduler.config.k8s.io/v1beta2
+bindTimeoutSeconds: 0
+bindingWorkerPoolSize: 0
 clientConnection:
   acceptContentTypes: ""
   burst: 0
@@ -1144,6 +1146,7 @@ parallelism: 8
 percentageOfNodesToScore: 0
 podInitialBackoffSeconds: 0
 podMaxBackoffSeconds: 0
+preBindPluginTimeoutSeconds: 0
 profiles:
 - pluginConfig:
   - args:
@@ -1163,7 +1166,11 @@ profiles:
   - args:
       apiVersion: kubescheduler.config.k8s.io/v1beta2
       bindTimeoutSeconds: 300
+      enableBatchReservation: false
+      enableCapacityReservationPublishing: false
+      enableCrossSchedulerReservation: false
       kind: VolumeBindingArgs
+      maxAttachmentsPerVolume: 0
     name: VolumeBinding
   - args:
       apiVersion: kubescheduler.config.k8s.io/v1beta2
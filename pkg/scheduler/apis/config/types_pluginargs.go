@@ -229,6 +229,42 @@ type VolumeBindingArgs struct {
 	// +featureGate=VolumeCapacityPriority
 	// +optional
 	Shape []UtilizationShapePoint
+
+	// EnableBatchReservation opts into briefly caching a node's Filter
+	// rejection per owning controller (for example, several StatefulSet
+	// replicas created together) and set of claims, so that a repeated Filter
+	// call for a pod with the same claims against that node reuses the
+	// rejection instead of re-deriving PV candidate sets. Feasible results are
+	// not cached, and volumes are still matched and reserved one pod at a time.
+	// Disabled by default.
+	// +optional
+	EnableBatchReservation bool
+
+	// EnableCrossSchedulerReservation opts into recording provisional PV
+	// reservations as a PV annotation with a TTL, so that other scheduler
+	// instances (running other profiles, or a second active scheduler in an
+	// HA/multi-scheduler setup) can see a reservation before the real bind
+	// lands and avoid racing to assume the same PV. Without this, assumed
+	// bindings are only visible in the local scheduler's assume cache.
+	// Disabled by default.
+	// +optional
+	EnableCrossSchedulerReservation bool
+
+	// EnableCapacityReservationPublishing opts into publishing a ConfigMap
+	// recording pending dynamic-provisioning demand once the binder has made
+	// its API update to trigger provisioning on a node, so that external capacity planners
+	// (cluster autoscalers, capacity dashboards) can observe the demand
+	// before the PV actually exists.
+	// Disabled by default.
+	// +optional
+	EnableCapacityReservationPublishing bool
+
+	// MaxAttachmentsPerVolume caps how many nodes may simultaneously have a
+	// pod assumed or bound against the same PV. Filter rejects nodes that
+	// would push a shared (for example RWX) PV over this limit. The value
+	// zero means no limit.
+	// +optional
+	MaxAttachmentsPerVolume int32
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
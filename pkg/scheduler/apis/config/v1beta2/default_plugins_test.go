@@ -372,3 +372,46 @@ func TestMergePlugins(t *testing.T) {
 		})
 	}
 }
+
+// TestMoveVolumeBindingFilterLate confirms a profile can reorder Filter so
+// that the expensive VolumeBinding plugin runs after the cheaper filters
+// have had a chance to reject the node, by disabling every default plugin
+// and relisting them, VolumeBinding last, in Enabled.
+func TestMoveVolumeBindingFilterLate(t *testing.T) {
+	wantOrder := []string{
+		names.NodeUnschedulable,
+		names.NodeName,
+		names.TaintToleration,
+		names.NodeAffinity,
+		names.NodePorts,
+		names.NodeResourcesFit,
+		names.VolumeRestrictions,
+		names.EBSLimits,
+		names.GCEPDLimits,
+		names.NodeVolumeLimits,
+		names.AzureDiskLimits,
+		names.VolumeZone,
+		names.PodTopologySpread,
+		names.InterPodAffinity,
+		names.VolumeBinding,
+	}
+
+	custom := &v1beta2.Plugins{
+		Filter: v1beta2.PluginSet{
+			Disabled: []v1beta2.Plugin{{Name: "*"}},
+		},
+	}
+	for _, name := range wantOrder {
+		custom.Filter.Enabled = append(custom.Filter.Enabled, v1beta2.Plugin{Name: name})
+	}
+
+	merged := mergePlugins(getDefaultPlugins(), custom)
+
+	var gotOrder []string
+	for _, plugin := range merged.Filter.Enabled {
+		gotOrder = append(gotOrder, plugin.Name)
+	}
+	if d := cmp.Diff(wantOrder, gotOrder); d != "" {
+		t.Fatalf("Filter order mismatch (-want +got):\n%s", d)
+	}
+}
@@ -210,6 +210,21 @@ func SetDefaults_KubeSchedulerConfiguration(obj *v1beta2.KubeSchedulerConfigurat
 		obj.PodMaxBackoffSeconds = &val
 	}
 
+	if obj.BindTimeoutSeconds == nil {
+		val := int64(0)
+		obj.BindTimeoutSeconds = &val
+	}
+
+	if obj.PreBindPluginTimeoutSeconds == nil {
+		val := int64(0)
+		obj.PreBindPluginTimeoutSeconds = &val
+	}
+
+	if obj.BindingWorkerPoolSize == nil {
+		val := int32(0)
+		obj.BindingWorkerPoolSize = &val
+	}
+
 	// Enable profiling by default in the scheduler
 	if obj.EnableProfiling == nil {
 		enableProfiling := true
@@ -245,6 +260,18 @@ func SetDefaults_VolumeBindingArgs(obj *v1beta2.VolumeBindingArgs) {
 	if obj.BindTimeoutSeconds == nil {
 		obj.BindTimeoutSeconds = pointer.Int64Ptr(600)
 	}
+	if obj.EnableBatchReservation == nil {
+		obj.EnableBatchReservation = pointer.BoolPtr(false)
+	}
+	if obj.EnableCrossSchedulerReservation == nil {
+		obj.EnableCrossSchedulerReservation = pointer.BoolPtr(false)
+	}
+	if obj.EnableCapacityReservationPublishing == nil {
+		obj.EnableCapacityReservationPublishing = pointer.BoolPtr(false)
+	}
+	if obj.MaxAttachmentsPerVolume == nil {
+		obj.MaxAttachmentsPerVolume = pointer.Int32Ptr(0)
+	}
 	if len(obj.Shape) == 0 && feature.DefaultFeatureGate.Enabled(features.VolumeCapacityPriority) {
 		obj.Shape = []v1beta2.UtilizationShapePoint{
 			{
@@ -99,7 +99,11 @@ var pluginConfigs = []v1beta2.PluginConfig{
 				Kind:       "VolumeBindingArgs",
 				APIVersion: "kubescheduler.config.k8s.io/v1beta2",
 			},
-			BindTimeoutSeconds: pointer.Int64Ptr(600),
+			BindTimeoutSeconds:                  pointer.Int64Ptr(600),
+			EnableBatchReservation:              pointer.BoolPtr(false),
+			EnableCrossSchedulerReservation:     pointer.BoolPtr(false),
+			EnableCapacityReservationPublishing: pointer.BoolPtr(false),
+			MaxAttachmentsPerVolume:             pointer.Int32Ptr(0),
 		}},
 	},
 }
@@ -136,9 +140,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins:       getDefaultPlugins(),
@@ -175,9 +182,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						SchedulerName: pointer.StringPtr("default-scheduler"),
@@ -233,9 +243,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins: getDefaultPlugins(),
@@ -303,7 +316,11 @@ func TestSchedulerDefaults(t *testing.T) {
 										Kind:       "VolumeBindingArgs",
 										APIVersion: "kubescheduler.config.k8s.io/v1beta2",
 									},
-									BindTimeoutSeconds: pointer.Int64Ptr(600),
+									BindTimeoutSeconds:                  pointer.Int64Ptr(600),
+									EnableBatchReservation:              pointer.BoolPtr(false),
+									EnableCrossSchedulerReservation:     pointer.BoolPtr(false),
+									EnableCapacityReservationPublishing: pointer.BoolPtr(false),
+									MaxAttachmentsPerVolume:             pointer.Int32Ptr(0),
 								}},
 							},
 						},
@@ -420,9 +437,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins:       getDefaultPlugins(),
@@ -460,9 +480,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins:       getDefaultPlugins(),
@@ -500,9 +523,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins:       getDefaultPlugins(),
@@ -539,9 +565,12 @@ func TestSchedulerDefaults(t *testing.T) {
 					Burst:       100,
 					ContentType: "application/vnd.kubernetes.protobuf",
 				},
-				PercentageOfNodesToScore: pointer.Int32Ptr(0),
-				PodInitialBackoffSeconds: pointer.Int64Ptr(1),
-				PodMaxBackoffSeconds:     pointer.Int64Ptr(10),
+				PercentageOfNodesToScore:    pointer.Int32Ptr(0),
+				PodInitialBackoffSeconds:    pointer.Int64Ptr(1),
+				PodMaxBackoffSeconds:        pointer.Int64Ptr(10),
+				BindTimeoutSeconds:          pointer.Int64Ptr(0),
+				PreBindPluginTimeoutSeconds: pointer.Int64Ptr(0),
+				BindingWorkerPoolSize:       pointer.Int32Ptr(0),
 				Profiles: []v1beta2.KubeSchedulerProfile{
 					{
 						Plugins:       getDefaultPlugins(),
@@ -682,7 +711,11 @@ func TestPluginArgsDefaults(t *testing.T) {
 			},
 			in: &v1beta2.VolumeBindingArgs{},
 			want: &v1beta2.VolumeBindingArgs{
-				BindTimeoutSeconds: pointer.Int64Ptr(600),
+				BindTimeoutSeconds:                  pointer.Int64Ptr(600),
+				EnableBatchReservation:              pointer.BoolPtr(false),
+				EnableCrossSchedulerReservation:     pointer.BoolPtr(false),
+				EnableCapacityReservationPublishing: pointer.BoolPtr(false),
+				MaxAttachmentsPerVolume:             pointer.Int32Ptr(0),
 			},
 		},
 		{
@@ -697,6 +730,10 @@ func TestPluginArgsDefaults(t *testing.T) {
 					{Utilization: 0, Score: 0},
 					{Utilization: 100, Score: 10},
 				},
+				EnableBatchReservation:              pointer.BoolPtr(false),
+				EnableCrossSchedulerReservation:     pointer.BoolPtr(false),
+				EnableCapacityReservationPublishing: pointer.BoolPtr(false),
+				MaxAttachmentsPerVolume:             pointer.Int32Ptr(0),
 			},
 		},
 	}
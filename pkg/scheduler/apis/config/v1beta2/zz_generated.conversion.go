@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -337,6 +338,15 @@ func autoConvert_v1beta2_KubeSchedulerConfiguration_To_config_KubeSchedulerConfi
 	if err := v1.Convert_Pointer_int64_To_int64(&in.PodMaxBackoffSeconds, &out.PodMaxBackoffSeconds, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_int64_To_int64(&in.BindTimeoutSeconds, &out.BindTimeoutSeconds, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_int64_To_int64(&in.PreBindPluginTimeoutSeconds, &out.PreBindPluginTimeoutSeconds, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_int32_To_int32(&in.BindingWorkerPoolSize, &out.BindingWorkerPoolSize, s); err != nil {
+		return err
+	}
 	if in.Profiles != nil {
 		in, out := &in.Profiles, &out.Profiles
 		*out = make([]config.KubeSchedulerProfile, len(*in))
@@ -380,6 +390,15 @@ func autoConvert_config_KubeSchedulerConfiguration_To_v1beta2_KubeSchedulerConfi
 	if err := v1.Convert_int64_To_Pointer_int64(&in.PodMaxBackoffSeconds, &out.PodMaxBackoffSeconds, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_int64_To_Pointer_int64(&in.BindTimeoutSeconds, &out.BindTimeoutSeconds, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_int64_To_Pointer_int64(&in.PreBindPluginTimeoutSeconds, &out.PreBindPluginTimeoutSeconds, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_int32_To_Pointer_int32(&in.BindingWorkerPoolSize, &out.BindingWorkerPoolSize, s); err != nil {
+		return err
+	}
 	if in.Profiles != nil {
 		in, out := &in.Profiles, &out.Profiles
 		*out = make([]v1beta2.KubeSchedulerProfile, len(*in))
@@ -816,6 +835,18 @@ func autoConvert_v1beta2_VolumeBindingArgs_To_config_VolumeBindingArgs(in *v1bet
 		return err
 	}
 	out.Shape = *(*[]config.UtilizationShapePoint)(unsafe.Pointer(&in.Shape))
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableBatchReservation, &out.EnableBatchReservation, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCrossSchedulerReservation, &out.EnableCrossSchedulerReservation, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCapacityReservationPublishing, &out.EnableCapacityReservationPublishing, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_int32_To_int32(&in.MaxAttachmentsPerVolume, &out.MaxAttachmentsPerVolume, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -829,6 +860,18 @@ func autoConvert_config_VolumeBindingArgs_To_v1beta2_VolumeBindingArgs(in *confi
 		return err
 	}
 	out.Shape = *(*[]v1beta2.UtilizationShapePoint)(unsafe.Pointer(&in.Shape))
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableBatchReservation, &out.EnableBatchReservation, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCrossSchedulerReservation, &out.EnableCrossSchedulerReservation, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCapacityReservationPublishing, &out.EnableCapacityReservationPublishing, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_int32_To_Pointer_int32(&in.MaxAttachmentsPerVolume, &out.MaxAttachmentsPerVolume, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -77,6 +77,18 @@ func ValidateKubeSchedulerConfiguration(cc *config.KubeSchedulerConfiguration) u
 		errs = append(errs, field.Invalid(field.NewPath("podMaxBackoffSeconds"),
 			cc.PodMaxBackoffSeconds, "must be greater than or equal to PodInitialBackoffSeconds"))
 	}
+	if cc.BindTimeoutSeconds < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("bindTimeoutSeconds"),
+			cc.BindTimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	if cc.PreBindPluginTimeoutSeconds < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("preBindPluginTimeoutSeconds"),
+			cc.PreBindPluginTimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	if cc.BindingWorkerPoolSize < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("bindingWorkerPoolSize"),
+			cc.BindingWorkerPoolSize, "must be greater than or equal to 0"))
+	}
 
 	errs = append(errs, validateExtenders(field.NewPath("extenders"), cc.Extenders)...)
 	return utilerrors.Flatten(utilerrors.NewAggregate(errs))
@@ -302,6 +302,10 @@ func ValidateVolumeBindingArgs(path *field.Path, args *config.VolumeBindingArgs)
 		allErrs = append(allErrs, field.Invalid(path.Child("bindTimeoutSeconds"), args.BindTimeoutSeconds, "invalid BindTimeoutSeconds, should not be a negative value"))
 	}
 
+	if args.MaxAttachmentsPerVolume < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxAttachmentsPerVolume"), args.MaxAttachmentsPerVolume, "invalid MaxAttachmentsPerVolume, should not be a negative value"))
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(features.VolumeCapacityPriority) {
 		allErrs = append(allErrs, validateFunctionShape(args.Shape, path.Child("shape"))...)
 	} else if args.Shape != nil {
@@ -275,6 +275,7 @@ func convertToNodeNameToMetaVictims(
 func (h *HTTPExtender) Filter(
 	pod *v1.Pod,
 	nodes []*v1.Node,
+	alreadyFailedNodes framework.NodeToStatusMap,
 ) (filteredList []*v1.Node, failedNodes, failedAndUnresolvableNodes extenderv1.FailedNodesMap, err error) {
 	var (
 		result     extenderv1.ExtenderFilterResult
@@ -292,6 +293,14 @@ func (h *HTTPExtender) Filter(
 		return nodes, extenderv1.FailedNodesMap{}, extenderv1.FailedNodesMap{}, nil
 	}
 
+	var failedNodesStatuses extenderv1.FailedNodesMap
+	if len(alreadyFailedNodes) > 0 {
+		failedNodesStatuses = make(extenderv1.FailedNodesMap, len(alreadyFailedNodes))
+		for nodeName, status := range alreadyFailedNodes {
+			failedNodesStatuses[nodeName] = status.Message()
+		}
+	}
+
 	if h.nodeCacheCapable {
 		nodeNameSlice := make([]string, 0, len(nodes))
 		for _, node := range nodes {
@@ -306,9 +315,10 @@ func (h *HTTPExtender) Filter(
 	}
 
 	args = &extenderv1.ExtenderArgs{
-		Pod:       pod,
-		Nodes:     nodeList,
-		NodeNames: nodeNames,
+		Pod:                 pod,
+		Nodes:               nodeList,
+		NodeNames:           nodeNames,
+		FailedNodesStatuses: failedNodesStatuses,
 	}
 
 	if err := h.send(h.filterVerb, args, &result); err != nil {
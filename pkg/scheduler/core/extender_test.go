@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	extenderv1 "k8s.io/kube-scheduler/extender/v1"
 	schedulerapi "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/defaultbinder"
@@ -305,6 +306,38 @@ func createNode(name string) *v1.Node {
 	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
 }
 
+// recordingExtender is a minimal framework.Extender that records the
+// alreadyFailedNodes argument it was called with, so tests can verify
+// pre-existing filter failure reasons (e.g. volume binding conflicts) are
+// forwarded from the scheduler to extenders.
+type recordingExtender struct {
+	st.FakeExtender
+	gotAlreadyFailedNodes framework.NodeToStatusMap
+}
+
+func (e *recordingExtender) Filter(pod *v1.Pod, nodes []*v1.Node, alreadyFailedNodes framework.NodeToStatusMap) ([]*v1.Node, extenderv1.FailedNodesMap, extenderv1.FailedNodesMap, error) {
+	e.gotAlreadyFailedNodes = alreadyFailedNodes
+	return nodes, extenderv1.FailedNodesMap{}, extenderv1.FailedNodesMap{}, nil
+}
+
+func TestFindNodesThatPassExtendersForwardsAlreadyFailedNodes(t *testing.T) {
+	extender := &recordingExtender{FakeExtender: st.FakeExtender{Predicates: []st.FitPredicate{st.TruePredicateExtender}}}
+	statuses := framework.NodeToStatusMap{
+		"node2": framework.NewStatus(framework.UnschedulableAndUnresolvable, "node(s) didn't find available persistent volumes to bind"),
+	}
+
+	feasibleNodes, err := findNodesThatPassExtenders([]framework.Extender{extender}, &v1.Pod{}, []*v1.Node{createNode("node1")}, statuses)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(feasibleNodes) != 1 || feasibleNodes[0].Name != "node1" {
+		t.Fatalf("Unexpected feasible nodes: %+v", feasibleNodes)
+	}
+	if !reflect.DeepEqual(extender.gotAlreadyFailedNodes, statuses) {
+		t.Errorf("Expected extender to receive %+v, got %+v", statuses, extender.gotAlreadyFailedNodes)
+	}
+}
+
 func TestIsInterested(t *testing.T) {
 	mem := &HTTPExtender{
 		managedResources: sets.NewString(),
@@ -88,6 +88,9 @@ type Configurator struct {
 	extenders         []schedulerapi.Extender
 	frameworkCapturer FrameworkCapturer
 	parallellism      int32
+	// preBindPluginTimeoutSeconds bounds how long a single PreBind plugin
+	// call may run. Zero disables the per-plugin deadline.
+	preBindPluginTimeoutSeconds int64
 	// A "cluster event" -> "plugin names" map.
 	clusterEventMap map[framework.ClusterEvent]sets.String
 }
@@ -161,6 +164,7 @@ func (c *Configurator) create() (*Scheduler, error) {
 		frameworkruntime.WithClusterEventMap(c.clusterEventMap),
 		frameworkruntime.WithParallelism(int(c.parallellism)),
 		frameworkruntime.WithExtenders(extenders),
+		frameworkruntime.WithPreBindPluginTimeout(time.Duration(c.preBindPluginTimeoutSeconds)*time.Second),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("initializing profiles: %v", err)
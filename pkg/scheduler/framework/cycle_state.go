@@ -46,6 +46,50 @@ type CycleState struct {
 	storage map[StateKey]StateData
 	// if recordPluginMetrics is true, PluginExecutionDuration will be recorded for this cycle.
 	recordPluginMetrics bool
+
+	// snapshotOnce and snapshot back ReadOnly, letting many concurrent
+	// Filter goroutines share one copy of the storage map instead of each
+	// acquiring mx.
+	snapshotOnce sync.Once
+	snapshot     map[StateKey]StateData
+}
+
+// ReadOnlyCycleState is a lock-free view over a CycleState's storage as of
+// the first call to CycleState.ReadOnly() for that cycle. Plugins that only
+// read data written during PreFilter (and never Write afterwards, which is
+// already the contract most Filter plugins follow) can use it to avoid
+// contending on CycleState's mutex while many nodes are filtered in
+// parallel.
+type ReadOnlyCycleState struct {
+	storage map[StateKey]StateData
+}
+
+// Read retrieves data with the given "key" from the snapshot. If the key is
+// not present an error is returned. Unlike CycleState.Read, this does not
+// acquire any lock.
+func (r ReadOnlyCycleState) Read(key StateKey) (StateData, error) {
+	if v, ok := r.storage[key]; ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+// ReadOnly returns a ReadOnlyCycleState snapshotting c's storage. The
+// snapshot is taken at most once per CycleState (subsequent calls reuse it),
+// so callers must only use it once they are sure no more Writes are coming
+// for the keys they care about, e.g. from Filter onward for state populated
+// in PreFilter.
+func (c *CycleState) ReadOnly() ReadOnlyCycleState {
+	c.snapshotOnce.Do(func() {
+		c.mx.RLock()
+		defer c.mx.RUnlock()
+		snapshot := make(map[StateKey]StateData, len(c.storage))
+		for k, v := range c.storage {
+			snapshot[k] = v
+		}
+		c.snapshot = snapshot
+	})
+	return ReadOnlyCycleState{storage: c.snapshot}
 }
 
 // NewCycleState initializes a new CycleState and returns its pointer.
@@ -87,7 +131,12 @@ func (c *CycleState) Clone() *CycleState {
 // Read retrieves data with the given "key" from CycleState. If the key is not
 // present an error is returned.
 // This function is thread safe by acquiring an internal lock first.
+// A nil CycleState behaves as if it were empty, which keeps call sites (and
+// tests) that don't care about state from having to special-case it.
 func (c *CycleState) Read(key StateKey) (StateData, error) {
+	if c == nil {
+		return nil, ErrNotFound
+	}
 	c.mx.RLock()
 	defer c.mx.RUnlock()
 	if v, ok := c.storage[key]; ok {
@@ -98,8 +147,15 @@ func (c *CycleState) Read(key StateKey) (StateData, error) {
 
 // Write stores the given "val" in CycleState with the given "key".
 // This function is thread safe by acquiring an internal lock first.
+// Writing to a nil CycleState is a no-op.
 func (c *CycleState) Write(key StateKey, val StateData) {
+	if c == nil {
+		return
+	}
 	c.mx.Lock()
+	if c.storage == nil {
+		c.storage = make(map[StateKey]StateData)
+	}
 	c.storage[key] = val
 	c.mx.Unlock()
 }
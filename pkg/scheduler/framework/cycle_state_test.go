@@ -72,3 +72,28 @@ func TestCycleStateCloneNil(t *testing.T) {
 		t.Errorf("clone expected to be nil")
 	}
 }
+
+func TestCycleStateReadOnly(t *testing.T) {
+	var key StateKey = "key"
+	state := NewCycleState()
+	state.Write(key, &fakeData{data: "value1"})
+
+	ro := state.ReadOnly()
+	v, err := ro.Read(key)
+	if err != nil {
+		t.Fatalf("failed to read from ReadOnly snapshot: %v", err)
+	}
+	if got := v.(*fakeData).data; got != "value1" {
+		t.Errorf("got %q, expected %q", got, "value1")
+	}
+
+	if _, err := ro.Read("missing"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	// Writes after the snapshot was taken must not be observed by it.
+	state.Write("key2", &fakeData{data: "value2"})
+	if _, err := ro.Read("key2"); err != ErrNotFound {
+		t.Errorf("expected ReadOnly snapshot to not observe later writes, got %v", err)
+	}
+}
@@ -33,7 +33,11 @@ type Extender interface {
 	// The failedNodes and failedAndUnresolvableNodes optionally contains the list
 	// of failed nodes and failure reasons, except nodes in the latter are
 	// unresolvable.
-	Filter(pod *v1.Pod, nodes []*v1.Node) (filteredNodes []*v1.Node, failedNodesMap extenderv1.FailedNodesMap, failedAndUnresolvable extenderv1.FailedNodesMap, err error)
+	// alreadyFailedNodes carries the failure reasons in-tree filter plugins (e.g.
+	// VolumeBinding) have already recorded for nodes that didn't make it into
+	// nodes; it's forwarded to the extender for observability only, since those
+	// nodes cannot be added back.
+	Filter(pod *v1.Pod, nodes []*v1.Node, alreadyFailedNodes NodeToStatusMap) (filteredNodes []*v1.Node, failedNodesMap extenderv1.FailedNodesMap, failedAndUnresolvable extenderv1.FailedNodesMap, err error)
 
 	// Prioritize based on extender-implemented priority functions. The returned scores & weight
 	// are used to compute the weighted score for an extender. The weighted scores are added to
@@ -28,10 +28,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/internal/parallelize"
@@ -116,6 +118,23 @@ type Status struct {
 	// failedPlugin is an optional field that records the plugin name a Pod failed by.
 	// It's set by the framework when code is Error, Unschedulable or UnschedulableAndUnresolvable.
 	failedPlugin string
+	// diagnostics are structured key/value details a Filter plugin can
+	// attach instead of (or in addition to) a free-text reason, e.g. which
+	// PersistentVolumeClaim couldn't be satisfied. FitError aggregates these
+	// into the pod's scheduling failure event when present, in place of the
+	// corresponding free-text reasons.
+	diagnostics []Diagnostic
+}
+
+// Diagnostic is a structured key/value detail a Filter plugin can attach to
+// a Status to explain why a node was rejected, e.g. {Key:
+// "persistentVolumeClaim", Value: "default/data-0: node(s) had volume node
+// affinity conflict"}. Unlike a free-text reason, Key lets the scheduler
+// group and count diagnostics from different nodes that failed for the same
+// underlying cause.
+type Diagnostic struct {
+	Key   string
+	Value string
 }
 
 // Code returns code of the Status.
@@ -161,6 +180,21 @@ func (s *Status) AppendReason(reason string) {
 	s.reasons = append(s.reasons, reason)
 }
 
+// AddDiagnostic attaches a structured key/value diagnostic to the Status, in
+// addition to any free-text reasons. FitError prefers a node's diagnostics
+// over its reasons when aggregating per-node failures into the pod's
+// scheduling failure event, so plugins that can identify a root cause (e.g.
+// which PersistentVolumeClaim couldn't be satisfied) should call this
+// instead of, or in addition to, AppendReason.
+func (s *Status) AddDiagnostic(key, value string) {
+	s.diagnostics = append(s.diagnostics, Diagnostic{Key: key, Value: value})
+}
+
+// Diagnostics returns the structured diagnostics of the Status.
+func (s *Status) Diagnostics() []Diagnostic {
+	return s.diagnostics
+}
+
 // IsSuccess returns true if and only if "Status" is nil or Code is "Success".
 func (s *Status) IsSuccess() bool {
 	return s.Code() == Success
@@ -269,6 +303,17 @@ type Plugin interface {
 	Name() string
 }
 
+// UpdatablePlugin is an optional interface a plugin can implement to accept
+// new Args without the profile's plugins being rebuilt, so config changes
+// (e.g. a new VolumeBindingArgs.BindTimeoutSeconds) don't require restarting
+// the scheduler and losing in-memory state like assumed volume bindings.
+// UpdateArgs is responsible for validating args itself, the same way the
+// plugin's factory function would.
+type UpdatablePlugin interface {
+	Plugin
+	UpdateArgs(args runtime.Object) error
+}
+
 // LessFunc is the function to sort pod info
 type LessFunc func(podInfo1, podInfo2 *QueuedPodInfo) bool
 
@@ -527,6 +572,18 @@ type Framework interface {
 	// ListPlugins returns a map of extension point name to list of configured Plugins.
 	ListPlugins() *config.Plugins
 
+	// Plugin returns the plugin instance configured under name for this
+	// profile, regardless of which extension points it implements. It's
+	// meant for debug/introspection tooling that needs to reach a specific
+	// plugin outside the normal extension-point invocation paths.
+	Plugin(name string) (Plugin, bool)
+
+	// UpdatePluginArgs applies args to the plugin configured under name, if
+	// that plugin implements UpdatablePlugin. It returns an error if the
+	// plugin isn't configured, doesn't implement UpdatablePlugin, or rejects
+	// args.
+	UpdatePluginArgs(name string, args runtime.Object) error
+
 	// ProfileName returns the profile name associated to this framework.
 	ProfileName() string
 }
@@ -568,6 +625,16 @@ type Handle interface {
 
 	SharedInformerFactory() informers.SharedInformerFactory
 
+	// RegisterEventHandler registers handler on informer for the lifetime of
+	// the scheduler, keyed by key. If a handler was already registered under
+	// key (e.g. because another instance of the same plugin, or the same
+	// plugin reloaded across a restart of this profile, calls in again), the
+	// existing registration is kept and false is returned so callers don't
+	// end up processing the same event twice. Plugins should pick a key that
+	// is stable across instantiations of the same logical handler, such as
+	// their plugin Name().
+	RegisterEventHandler(key string, informer cache.SharedIndexInformer, handler cache.ResourceEventHandler) bool
+
 	// RunFilterPluginsWithNominatedPods runs the set of configured filter plugins for nominated pod on the given node.
 	RunFilterPluginsWithNominatedPods(ctx context.Context, state *CycleState, pod *v1.Pod, info *NodeInfo) *Status
 
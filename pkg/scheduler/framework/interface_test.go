@@ -214,3 +214,27 @@ func TestIsStatusEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusDiagnostics(t *testing.T) {
+	status := NewStatus(Unschedulable, "node(s) had volume node affinity conflict")
+	if got := status.Diagnostics(); got != nil {
+		t.Errorf("Diagnostics() on a status with none = %v, want nil", got)
+	}
+
+	status.AddDiagnostic("persistentvolumeclaim/default/data-0", "node(s) had volume node affinity conflict")
+	status.AddDiagnostic("persistentvolumeclaim/default/data-1", "node(s) didn't find available persistent volumes to bind")
+
+	want := []Diagnostic{
+		{Key: "persistentvolumeclaim/default/data-0", Value: "node(s) had volume node affinity conflict"},
+		{Key: "persistentvolumeclaim/default/data-1", Value: "node(s) didn't find available persistent volumes to bind"},
+	}
+	if got := status.Diagnostics(); !cmp.Equal(got, want) {
+		t.Errorf("Diagnostics() = %v, want %v", got, want)
+	}
+
+	// Diagnostics are additional to, not a replacement for, reasons: existing
+	// callers of Reasons()/AppendReason() are unaffected.
+	if got := status.Reasons(); !cmp.Equal(got, []string{"node(s) had volume node affinity conflict"}) {
+		t.Errorf("Reasons() = %v, want unaffected by AddDiagnostic", got)
+	}
+}
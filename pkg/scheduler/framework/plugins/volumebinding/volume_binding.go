@@ -20,22 +20,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	storagehelpers "k8s.io/component-helpers/storage/volume"
 	"k8s.io/klog/v2"
+	pvutil "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/util"
 	"k8s.io/kubernetes/pkg/controller/volume/scheduling"
+	volumeschedulingmetrics "k8s.io/kubernetes/pkg/controller/volume/scheduling/metrics"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/apis/config/validation"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/helper"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
+	"k8s.io/kubernetes/pkg/scheduler/metrics"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
 )
 
 const (
@@ -45,6 +56,21 @@ const (
 	stateKey framework.StateKey = Name
 
 	maxUtilization = 100
+
+	// crossSchedulerReservationTTL bounds how long a PV reservation made
+	// visible to other scheduler instances via EnableCrossSchedulerReservation
+	// is honored if this instance never clears it (e.g. it crashed between
+	// assuming the PV and binding it).
+	crossSchedulerReservationTTL = 2 * time.Minute
+
+	// PodPinnedByVolumeAnnotation is set on a pod in PreBind when it was
+	// admitted to exactly one node because the combined node affinity of its
+	// already-bound PVs ruled out every other node (see
+	// nominateNodeFromBoundPVs). Its value is the node the pod is pinned to.
+	// Deschedulers and drain tooling can check for it to recognize that the
+	// pod cannot simply be evicted and rescheduled elsewhere without also
+	// migrating its volumes.
+	PodPinnedByVolumeAnnotation = "volume.kubernetes.io/pinned-node"
 )
 
 // the state is initialized in PreFilter phase. because we save the pointer in
@@ -59,6 +85,12 @@ type stateData struct {
 	// phase for each node
 	// it's initialized in the PreFilter phase
 	podVolumesByNode map[string]*scheduling.PodVolumes
+	// nominatedNodeName is set in PreFilter when every PVC of the pod is
+	// already bound and the combined node affinity of their PVs admits
+	// exactly one node in the cluster. Filter uses it to reject every other
+	// node without re-deriving volume placement for them. See
+	// nominateNodeFromBoundPVs.
+	nominatedNodeName string
 	sync.Mutex
 }
 
@@ -73,7 +105,112 @@ type VolumeBinding struct {
 	Binder                               scheduling.SchedulerVolumeBinder
 	PVCLister                            corelisters.PersistentVolumeClaimLister
 	GenericEphemeralVolumeFeatureEnabled bool
-	scorer                               volumeCapacityScorer
+	// PVLister is used by PreFilter to nominate a single candidate node when
+	// every PVC of the pod is already bound (see nominateNodeFromBoundPVs).
+	// It is nil for plugins constructed via NewFromVolumeBinder, in which
+	// case the nomination optimization is skipped.
+	PVLister corelisters.PersistentVolumeLister
+	scorer   volumeCapacityScorer
+	// handle is used in Reserve/Unreserve to track assumed PV capacity
+	// against the node in the scheduler's snapshot for resource scoring.
+	// It is nil for plugins constructed via NewFromVolumeBinder, in which
+	// case capacity tracking is skipped.
+	handle framework.Handle
+	// batchReservationEnabled and batchCache implement the opt-in
+	// VolumeBindingArgs.EnableBatchReservation behavior: a failing Filter
+	// result for a node is cached per owning controller and claim state, so a repeat
+	// Filter call against the same pod (or another pod backed by the exact
+	// same claims) skips re-deriving PV candidate sets from scratch. The
+	// cache is keyed on claim identity, not just the owner, so pods with
+	// their own independent claims (e.g. StatefulSet replicas, each with
+	// their own volumeClaimTemplates-derived PVC) never share results.
+	batchReservationEnabled bool
+	batchCache              *batchFilterCache
+	// crossSchedulerReservationEnabled mirrors VolumeBindingArgs.
+	// EnableCrossSchedulerReservation, recorded so UpdateArgs can reject an
+	// attempt to flip it on a running plugin (the underlying Binder isn't
+	// safe to swap).
+	crossSchedulerReservationEnabled bool
+	// capacityReservationPublishingEnabled mirrors VolumeBindingArgs.
+	// EnableCapacityReservationPublishing, recorded so UpdateArgs can reject
+	// an attempt to flip it on a running plugin (the underlying Binder isn't
+	// safe to swap).
+	capacityReservationPublishingEnabled bool
+	// inFlightBinds tracks pods currently blocked in PreBind waiting for
+	// Binder.BindPodVolumes to complete, keyed by pod UID. It backs
+	// InFlightVolumeBinds, which lets debug tooling diagnose stuck rollouts
+	// (e.g. a StatefulSet stuck recreating pods) without log spelunking.
+	inFlightBinds sync.Map
+	// attachmentLimit mirrors VolumeBindingArgs.MaxAttachmentsPerVolume. Zero
+	// means unlimited, in which case assumedAttachments is never populated.
+	attachmentLimit int32
+	// assumedAttachmentsMutex guards assumedAttachments.
+	assumedAttachmentsMutex sync.Mutex
+	// assumedAttachments tracks, per PV name, how many pods Reserve has
+	// assumed (or Filter has observed already bound) onto each node. It
+	// backs checkAttachmentLimit, which rejects nodes that would push a
+	// shared (e.g. RWX) PV past attachmentLimit. The inner map is keyed by
+	// node name, with a reference count so that multiple pods sharing the
+	// same PV on the same node are counted as a single attachment.
+	assumedAttachments map[string]map[string]int
+	// clock is used for time-based bookkeeping (currently, expiring
+	// batchFilterCache entries). Defaults to the real clock; overridable via
+	// WithClock for deterministic tests in out-of-tree embeddings.
+	clock clock.Clock
+}
+
+// InFlightVolumeBind describes a pod currently blocked in PreBind waiting for
+// its volumes to be bound.
+type InFlightVolumeBind struct {
+	// Pod is the namespace/name of the blocked pod.
+	Pod types.NamespacedName
+	// Node is the node the pod is being bound to.
+	Node string
+	// Elapsed is how long the pod has been waiting so far.
+	Elapsed time.Duration
+	// PendingPVCs are the names of the PVCs being bound or provisioned for
+	// this pod.
+	PendingPVCs []string
+}
+
+type inFlightBind struct {
+	pod         types.NamespacedName
+	node        string
+	start       time.Time
+	pendingPVCs []string
+}
+
+// InFlightVolumeBinds returns the pods currently blocked in PreBind, for
+// debug/introspection tooling.
+func (pl *VolumeBinding) InFlightVolumeBinds() []InFlightVolumeBind {
+	var result []InFlightVolumeBind
+	pl.inFlightBinds.Range(func(_, value interface{}) bool {
+		b := value.(*inFlightBind)
+		result = append(result, InFlightVolumeBind{
+			Pod:         b.pod,
+			Node:        b.node,
+			Elapsed:     time.Since(b.start),
+			PendingPVCs: b.pendingPVCs,
+		})
+		return true
+	})
+	return result
+}
+
+// pendingPVCNames returns the names of the PVCs podVolumes still needs bound
+// or provisioned.
+func pendingPVCNames(podVolumes *scheduling.PodVolumes) []string {
+	if podVolumes == nil {
+		return nil
+	}
+	names := make([]string, 0, len(podVolumes.StaticBindings)+len(podVolumes.DynamicProvisions))
+	for _, b := range podVolumes.StaticBindings {
+		names = append(names, b.PVC().Name)
+	}
+	for _, pvc := range podVolumes.DynamicProvisions {
+		names = append(names, pvc.Name)
+	}
+	return names
 }
 
 var _ framework.PreFilterPlugin = &VolumeBinding{}
@@ -149,17 +286,85 @@ func (pl *VolumeBinding) PreFilter(ctx context.Context, state *framework.CycleSt
 		status.AppendReason("pod has unbound immediate PersistentVolumeClaims")
 		return status
 	}
-	state.Write(stateKey, &stateData{boundClaims: boundClaims, claimsToBind: claimsToBind, podVolumesByNode: make(map[string]*scheduling.PodVolumes)})
+	data := &stateData{boundClaims: boundClaims, claimsToBind: claimsToBind, podVolumesByNode: make(map[string]*scheduling.PodVolumes)}
+	if len(claimsToBind) == 0 {
+		data.nominatedNodeName = pl.nominateNodeFromBoundPVs(boundClaims)
+	}
+	state.Write(stateKey, data)
 	return nil
 }
 
+// nominateNodeFromBoundPVs looks at a pod whose PVCs are all already bound
+// and checks whether the combined node affinity of their PVs admits exactly
+// one node in the cluster. If so, it returns that node's name so Filter can
+// reject every other node without calling FindPodVolumes for them. This is
+// typically useful for a pod being recreated (e.g. a StatefulSet replica)
+// whose PVCs were already bound for a specific node by an earlier scheduling
+// cycle: the other candidate nodes can be rejected up front instead of each
+// doing the same node-affinity check independently. It returns "" when the
+// optimization doesn't apply (PVLister unset, no bound claims, zero or more
+// than one admissible node, or a lookup error -- any of which just falls
+// back to the normal per-node Filter path).
+func (pl *VolumeBinding) nominateNodeFromBoundPVs(boundClaims []*v1.PersistentVolumeClaim) string {
+	if pl.PVLister == nil || pl.handle == nil || len(boundClaims) == 0 {
+		return ""
+	}
+	pvs := make([]*v1.PersistentVolume, 0, len(boundClaims))
+	for _, claim := range boundClaims {
+		if claim.Spec.VolumeName == "" {
+			return ""
+		}
+		pv, err := pl.PVLister.Get(claim.Spec.VolumeName)
+		if err != nil {
+			return ""
+		}
+		pvs = append(pvs, pv)
+	}
+	sharedLister := pl.handle.SnapshotSharedLister()
+	if sharedLister == nil {
+		return ""
+	}
+	nodeInfos, err := sharedLister.NodeInfos().List()
+	if err != nil {
+		return ""
+	}
+	nominated := ""
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node()
+		if node == nil {
+			continue
+		}
+		admits := true
+		for _, pv := range pvs {
+			if err := volumeutil.CheckNodeAffinity(pv, node.Labels); err != nil {
+				admits = false
+				break
+			}
+		}
+		if !admits {
+			continue
+		}
+		if nominated != "" {
+			// More than one node admits these PVs; the optimization doesn't
+			// apply.
+			return ""
+		}
+		nominated = node.Name
+	}
+	return nominated
+}
+
 // PreFilterExtensions returns prefilter extensions, pod add and remove.
 func (pl *VolumeBinding) PreFilterExtensions() framework.PreFilterExtensions {
 	return nil
 }
 
+// getStateData reads the stateData written in PreFilter. It uses
+// CycleState's lock-free ReadOnly view since stateData is never rewritten
+// after PreFilter, which avoids CycleState's mutex becoming a contention
+// point when Filter runs concurrently across many nodes.
 func getStateData(cs *framework.CycleState) (*stateData, error) {
-	state, err := cs.Read(stateKey)
+	state, err := cs.ReadOnly().Read(stateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +405,35 @@ func (pl *VolumeBinding) Filter(ctx context.Context, cs *framework.CycleState, p
 		return nil
 	}
 
+	if state.nominatedNodeName != "" && node.Name != state.nominatedNodeName {
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable,
+			"node does not match the single node admitted by the pod's bound PV node affinity")
+	}
+
+	for _, claim := range state.claimsToBind {
+		hintedNode, required := preferredNodeHint(claim)
+		if required && node.Name != hintedNode {
+			return framework.NewStatus(framework.UnschedulableAndUnresolvable,
+				fmt.Sprintf("node does not match the node required by persistentvolumeclaim %s/%s", claim.Namespace, claim.Name))
+		}
+	}
+
+	var batchOwner types.UID
+	var batchKey batchCacheKey
+	if pl.batchReservationEnabled {
+		if owner := metav1.GetControllerOf(pod); owner != nil {
+			batchOwner = owner.UID
+			// Bound claims are part of the key too: a pod whose claims are
+			// all bound has an empty claimsToBind, and its result depends on
+			// which PVs those claims are bound to.
+			claims := append(append([]*v1.PersistentVolumeClaim{}, state.boundClaims...), state.claimsToBind...)
+			batchKey = batchCacheKey{nodeName: node.Name, claimsKey: claimsCacheKey(claims)}
+			if status, ok := pl.batchCache.get(batchOwner, batchKey); ok {
+				return status
+			}
+		}
+	}
+
 	podVolumes, reasons, err := pl.Binder.FindPodVolumes(pod, state.boundClaims, state.claimsToBind, node)
 
 	if err != nil {
@@ -207,10 +441,35 @@ func (pl *VolumeBinding) Filter(ctx context.Context, cs *framework.CycleState, p
 	}
 
 	if len(reasons) > 0 {
-		status := framework.NewStatus(framework.UnschedulableAndUnresolvable)
+		// Only mark the node UnschedulableAndUnresolvable if every reason is
+		// one preemption can't help with. If at least one reason is
+		// resolvable (e.g. a PV another pending pod has only provisionally
+		// reserved), report plain Unschedulable so PostFilter/preemption
+		// still considers evicting that pod.
+		code := framework.Unschedulable
+		for _, reason := range reasons {
+			if !reason.Resolvable() {
+				code = framework.UnschedulableAndUnresolvable
+				break
+			}
+		}
+		status := framework.NewStatus(code)
 		for _, reason := range reasons {
 			status.AppendReason(string(reason))
 		}
+		for _, claim := range state.claimsToBind {
+			for _, reason := range reasons {
+				status.AddDiagnostic(fmt.Sprintf("persistentvolumeclaim/%s/%s", claim.Namespace, claim.Name), string(reason))
+			}
+		}
+		recordUnschedulablePod(state.claimsToBind, reasons)
+		if batchOwner != "" {
+			pl.batchCache.record(batchOwner, batchKey, status)
+		}
+		return status
+	}
+
+	if status := pl.checkAttachmentLimit(node.Name, state.boundClaims, podVolumes); status != nil {
 		return status
 	}
 
@@ -221,15 +480,68 @@ func (pl *VolumeBinding) Filter(ctx context.Context, cs *framework.CycleState, p
 	return nil
 }
 
+// preferredNodeHint returns the node name an external provisioner hinted for
+// claim via AnnPreferredNode, and whether AnnRequiredNode was also set to
+// turn that hint into a hard requirement. It returns ("", false) if claim
+// carries no hint.
+func preferredNodeHint(claim *v1.PersistentVolumeClaim) (nodeName string, required bool) {
+	nodeName, ok := claim.Annotations[pvutil.AnnPreferredNode]
+	if !ok || nodeName == "" {
+		return "", false
+	}
+	_, required = claim.Annotations[pvutil.AnnRequiredNode]
+	return nodeName, required
+}
+
+// recordUnschedulablePod reports a node rejection due to volume binding for
+// every (storage class, reason) pair it could be attributed to, so storage
+// teams can see which classes are short on capacity or misconfigured
+// topology.
+func recordUnschedulablePod(claimsToBind []*v1.PersistentVolumeClaim, reasons scheduling.ConflictReasons) {
+	storageClasses := map[string]bool{}
+	for _, claim := range claimsToBind {
+		storageClasses[storagehelpers.GetPersistentVolumeClaimClass(claim)] = true
+	}
+	for storageClass := range storageClasses {
+		for _, reason := range reasons {
+			volumeschedulingmetrics.VolumeBindingUnschedulablePod.WithLabelValues(storageClass, string(reason)).Inc()
+		}
+	}
+}
+
+// SimulatedPodVolumes returns the volume binding plan Filter computed for
+// nodeName in cs, without Reserving or binding anything. It's meant for
+// dry-run/simulation callers (see scheduler.SimulatePod) that want to know
+// where a pod's volumes would land without mutating the real assume cache or
+// the API server.
+func (pl *VolumeBinding) SimulatedPodVolumes(cs *framework.CycleState, nodeName string) (*scheduling.PodVolumes, bool) {
+	state, err := getStateData(cs)
+	if err != nil || state.skip {
+		return nil, false
+	}
+	state.Lock()
+	defer state.Unlock()
+	podVolumes, ok := state.podVolumesByNode[nodeName]
+	return podVolumes, ok
+}
+
 // Score invoked at the score extension point.
 func (pl *VolumeBinding) Score(ctx context.Context, cs *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
-	if pl.scorer == nil {
-		return 0, nil
-	}
 	state, err := getStateData(cs)
 	if err != nil {
 		return 0, framework.AsStatus(err)
 	}
+	for _, claim := range state.claimsToBind {
+		if hintedNode, _ := preferredNodeHint(claim); hintedNode == nodeName {
+			// A provisioner-published hint takes priority over capacity
+			// utilization scoring: landing the volume near its data (e.g. a
+			// snapshot source) matters more than balancing PV usage.
+			return framework.MaxNodeScore, nil
+		}
+	}
+	if pl.scorer == nil {
+		return 0, nil
+	}
 	podVolumes, ok := state.podVolumesByNode[nodeName]
 	if !ok {
 		return 0, nil
@@ -270,6 +582,8 @@ func (pl *VolumeBinding) Reserve(ctx context.Context, cs *framework.CycleState,
 			return framework.AsStatus(err)
 		}
 		state.allBound = allBound
+		pl.trackAssumedPVCapacity(nodeName, podVolumes, true)
+		pl.trackAssumedAttachments(nodeName, state.boundClaims, podVolumes, true)
 	} else {
 		// may not exist if the pod does not reference any PVC
 		state.allBound = true
@@ -287,6 +601,9 @@ func (pl *VolumeBinding) PreBind(ctx context.Context, cs *framework.CycleState,
 	if err != nil {
 		return framework.AsStatus(err)
 	}
+	if s.nominatedNodeName != "" {
+		pl.annotatePinnedNode(ctx, pod, nodeName)
+	}
 	if s.allBound {
 		// no need to bind volumes
 		return nil
@@ -297,11 +614,28 @@ func (pl *VolumeBinding) PreBind(ctx context.Context, cs *framework.CycleState,
 		return framework.AsStatus(fmt.Errorf("no pod volumes found for node %q", nodeName))
 	}
 	klog.V(5).InfoS("Trying to bind volumes for pod", "pod", klog.KObj(pod))
+	start := time.Now()
+	pl.inFlightBinds.Store(pod.UID, &inFlightBind{
+		pod:         types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name},
+		node:        nodeName,
+		start:       start,
+		pendingPVCs: pendingPVCNames(podVolumes),
+	})
+	defer pl.inFlightBinds.Delete(pod.UID)
 	err = pl.Binder.BindPodVolumes(pod, podVolumes)
 	if err != nil {
+		metrics.PreBindVolumeWaitDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
 		klog.V(1).InfoS("Failed to bind volumes for pod", "pod", klog.KObj(pod), "err", err)
+		var claimDeleted *scheduling.ClaimDeletedError
+		if errors.As(err, &claimDeleted) {
+			// The claim is gone, so there is nothing left to wait for:
+			// fail this node outright instead of classifying it as a
+			// generic, potentially-retryable error.
+			return framework.NewStatus(framework.UnschedulableAndUnresolvable, err.Error())
+		}
 		return framework.AsStatus(err)
 	}
+	metrics.PreBindVolumeWaitDuration.WithLabelValues("success").Observe(time.Since(start).Seconds())
 	klog.V(5).InfoS("Success binding volumes for pod", "pod", klog.KObj(pod))
 	return nil
 }
@@ -319,15 +653,306 @@ func (pl *VolumeBinding) Unreserve(ctx context.Context, cs *framework.CycleState
 		return
 	}
 	pl.Binder.RevertAssumedPodVolumes(podVolumes)
+	pl.trackAssumedPVCapacity(nodeName, podVolumes, false)
+	pl.trackAssumedAttachments(nodeName, s.boundClaims, podVolumes, false)
 	return
 }
 
-// New initializes a new plugin and returns it.
+// annotatePinnedNode records PodPinnedByVolumeAnnotation on pod. It is
+// advisory only, mirroring reservePV's advisory annotation patching in the
+// scheduler_binder: a failure is logged and otherwise ignored, since nothing
+// in the scheduling path depends on the annotation being present. Plugins
+// built via NewFromVolumeBinder have no handle and skip it.
+func (pl *VolumeBinding) annotatePinnedNode(ctx context.Context, pod *v1.Pod, nodeName string) {
+	if pl.handle == nil {
+		return
+	}
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, PodPinnedByVolumeAnnotation, nodeName))
+	if _, err := pl.handle.ClientSet().CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.V(4).InfoS("Failed to annotate pod as pinned by volume node affinity", "pod", klog.KObj(pod), "node", nodeName, "err", err)
+	}
+}
+
+// trackAssumedPVCapacity updates the node's AssumedPVCapacity in the
+// scheduler's snapshot to reflect the static PV bindings assumed (add=true)
+// or reverted (add=false) for podVolumes. It is a best-effort accounting
+// hook: plugins built via NewFromVolumeBinder have no handle and skip it.
+func (pl *VolumeBinding) trackAssumedPVCapacity(nodeName string, podVolumes *scheduling.PodVolumes, add bool) {
+	if pl.handle == nil || len(podVolumes.StaticBindings) == 0 {
+		return
+	}
+	nodeInfo, err := pl.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return
+	}
+	var capacity int64
+	for _, b := range podVolumes.StaticBindings {
+		capacity += b.StorageResource().Capacity
+	}
+	if add {
+		nodeInfo.AddAssumedPVCapacity(capacity)
+	} else {
+		nodeInfo.RemoveAssumedPVCapacity(capacity)
+	}
+}
+
+// attachedPVNames returns the names of every PV that a pod with boundClaims
+// and podVolumes would have attached: PVs already bound via boundClaims
+// (covers a pod reusing a shared RWX/RWO volume another pod already bound),
+// plus the PVs podVolumes proposes to bind now.
+func attachedPVNames(boundClaims []*v1.PersistentVolumeClaim, podVolumes *scheduling.PodVolumes) sets.String {
+	pvNames := sets.NewString()
+	for _, claim := range boundClaims {
+		if claim.Spec.VolumeName != "" {
+			pvNames.Insert(claim.Spec.VolumeName)
+		}
+	}
+	for _, b := range podVolumes.StaticBindings {
+		pvNames.Insert(b.PVName())
+	}
+	return pvNames
+}
+
+// checkAttachmentLimit rejects nodeName if attaching any PV the pod would
+// use there (see attachedPVNames) would push that PV past attachmentLimit
+// distinct node attachments. A disabled limit (zero) always passes.
+func (pl *VolumeBinding) checkAttachmentLimit(nodeName string, boundClaims []*v1.PersistentVolumeClaim, podVolumes *scheduling.PodVolumes) *framework.Status {
+	if pl.attachmentLimit <= 0 {
+		return nil
+	}
+	pl.assumedAttachmentsMutex.Lock()
+	defer pl.assumedAttachmentsMutex.Unlock()
+	for _, pvName := range attachedPVNames(boundClaims, podVolumes).List() {
+		nodes := pl.assumedAttachments[pvName]
+		if _, attached := nodes[nodeName]; attached {
+			continue
+		}
+		if len(nodes) >= int(pl.attachmentLimit) {
+			return framework.NewStatus(framework.Unschedulable,
+				fmt.Sprintf("persistentvolume %q already has the maximum of %d node attachments", pvName, pl.attachmentLimit))
+		}
+	}
+	return nil
+}
+
+// trackAssumedAttachments records (add=true) or releases (add=false) this
+// pod's contribution to assumedAttachments for nodeName, mirroring
+// trackAssumedPVCapacity's Reserve/Unreserve call pattern. A PV is counted
+// as attached to a node as long as at least one pod there references it, so
+// multiple pods sharing the same PV on the same node share one attachment.
+func (pl *VolumeBinding) trackAssumedAttachments(nodeName string, boundClaims []*v1.PersistentVolumeClaim, podVolumes *scheduling.PodVolumes, add bool) {
+	if pl.attachmentLimit <= 0 {
+		return
+	}
+	pl.assumedAttachmentsMutex.Lock()
+	defer pl.assumedAttachmentsMutex.Unlock()
+	for _, pvName := range attachedPVNames(boundClaims, podVolumes).List() {
+		if add {
+			nodes := pl.assumedAttachments[pvName]
+			if nodes == nil {
+				nodes = map[string]int{}
+				pl.assumedAttachments[pvName] = nodes
+			}
+			nodes[nodeName]++
+			continue
+		}
+		nodes := pl.assumedAttachments[pvName]
+		if nodes == nil {
+			continue
+		}
+		nodes[nodeName]--
+		if nodes[nodeName] <= 0 {
+			delete(nodes, nodeName)
+		}
+		if len(nodes) == 0 {
+			delete(pl.assumedAttachments, pvName)
+		}
+	}
+}
+
+// batchReservationTTL bounds how long a cached Filter result for a pod is
+// reused for a later Filter call against the same node. It is intentionally
+// short: it only needs to cover the handful of scheduling cycles that run
+// back-to-back while a controller's pods are being created together.
+const batchReservationTTL = 2 * time.Second
+
+type batchCacheEntry struct {
+	status  *framework.Status
+	expires time.Time
+}
+
+// batchCacheKey identifies the claim state a cached Filter result applies
+// to. Pods with their own per-instance PVCs (e.g. StatefulSet replicas
+// using volumeClaimTemplates) each get their own claimsKey, so a result
+// computed for one pod is never replayed for another pod whose claims have
+// independent bound PVs or topology; the cache only ever saves a repeat
+// Filter call for the same pod (or for pods that genuinely share the same
+// claims, e.g. multiple pods referencing the same pre-bound PVC).
+type batchCacheKey struct {
+	nodeName  string
+	claimsKey string
+}
+
+// batchFilterCache records recent failing Filter results per owning controller UID
+// and claim state so that a Filter call that's repeated for the same pod
+// (or for other pods of the same owner backed by the identical claims) can
+// skip re-deriving PV candidate sets against a node already evaluated.
+type batchFilterCache struct {
+	mu      sync.Mutex
+	clock   clock.Clock
+	entries map[types.UID]map[batchCacheKey]batchCacheEntry // ownerUID -> (nodeName, claimsKey) -> entry
+}
+
+func newBatchFilterCache(c clock.Clock) *batchFilterCache {
+	return &batchFilterCache{clock: c, entries: make(map[types.UID]map[batchCacheKey]batchCacheEntry)}
+}
+
+// claimsCacheKey fingerprints the claims a Filter call resolved against, so
+// cache entries can never be shared between pods whose claims differ. Claim
+// UIDs are stable for the lifetime of the object and uniquely identify it,
+// unlike names, which can be reused (e.g. after a PVC is deleted and
+// recreated with a fresh UID).
+func claimsCacheKey(claimsToBind []*v1.PersistentVolumeClaim) string {
+	uids := make([]string, 0, len(claimsToBind))
+	for _, claim := range claimsToBind {
+		uids = append(uids, string(claim.UID))
+	}
+	sort.Strings(uids)
+	return strings.Join(uids, ",")
+}
+
+func (c *batchFilterCache) get(owner types.UID, key batchCacheKey) (*framework.Status, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[owner][key]
+	if !ok || c.clock.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.status, true
+}
+
+func (c *batchFilterCache) record(owner types.UID, key batchCacheKey, status *framework.Status) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	byKey, ok := c.entries[owner]
+	if !ok {
+		byKey = make(map[batchCacheKey]batchCacheEntry)
+		c.entries[owner] = byKey
+	}
+	byKey[key] = batchCacheEntry{status: status, expires: c.clock.Now().Add(batchReservationTTL)}
+	// Opportunistically bound memory by dropping other batches' expired entries.
+	for ownerUID, keys := range c.entries {
+		if ownerUID == owner {
+			continue
+		}
+		for k, e := range keys {
+			if c.clock.Now().After(e.expires) {
+				delete(keys, k)
+			}
+		}
+		if len(keys) == 0 {
+			delete(c.entries, ownerUID)
+		}
+	}
+}
+
+// Option configures a VolumeBinding plugin built with NewWithOptions.
+type Option func(*VolumeBinding)
+
+// WithBinder sets the SchedulerVolumeBinder the plugin delegates all PVC/PV
+// binding and provisioning decisions to. Build one with
+// scheduling.NewVolumeBinder against your own informers (optionally passing
+// a scheduling.CapacityCheck to enable CSI storage capacity checking), or
+// supply an entirely different implementation, to embed the stock
+// VolumeBinding plugin logic (Filter/Score/Reserve/PreBind/Unreserve) in a
+// custom scheduler build.
+func WithBinder(binder scheduling.SchedulerVolumeBinder) Option {
+	return func(pl *VolumeBinding) { pl.Binder = binder }
+}
+
+// WithPVCLister sets the lister PreFilter uses to resolve generic ephemeral
+// volumes into their backing PVCs.
+func WithPVCLister(lister corelisters.PersistentVolumeClaimLister) Option {
+	return func(pl *VolumeBinding) { pl.PVCLister = lister }
+}
+
+// WithGenericEphemeralVolumeFeature toggles generic ephemeral volume
+// support, mirroring the GenericEphemeralVolume feature gate New() reads.
+func WithGenericEphemeralVolumeFeature(enabled bool) Option {
+	return func(pl *VolumeBinding) { pl.GenericEphemeralVolumeFeatureEnabled = enabled }
+}
+
+// WithCapacityScorer sets the function Score uses to rank nodes by available
+// PV capacity (the VolumeCapacityPriority feature). This is distinct from
+// CSI storage capacity checking in Filter, which is configured on the
+// Binder itself via scheduling.CapacityCheck -- build a binder with it and
+// pass that to WithBinder instead.
+func WithCapacityScorer(scorer volumeCapacityScorer) Option {
+	return func(pl *VolumeBinding) { pl.scorer = scorer }
+}
+
+// WithClock overrides the clock the plugin uses for time-based bookkeeping
+// (currently, expiring batchFilterCache entries). Defaults to the real
+// clock; exposed so out-of-tree tests embedding the plugin can control time
+// deterministically.
+func WithClock(c clock.Clock) Option {
+	return func(pl *VolumeBinding) { pl.clock = c }
+}
+
+// NewWithOptions builds a VolumeBinding plugin directly from caller-supplied
+// dependencies instead of VolumeBindingArgs and a framework.Handle, so that
+// out-of-tree scheduler builds can embed the plugin with their own
+// informers, binder, and clock without vendoring this package's internals.
+// At minimum, WithBinder must be supplied.
+func NewWithOptions(opts ...Option) framework.Plugin {
+	pl := &VolumeBinding{clock: clock.RealClock{}, assumedAttachments: map[string]map[string]int{}}
+	for _, opt := range opts {
+		opt(pl)
+	}
+	return pl
+}
+
+// NewFromVolumeBinder initializes a new plugin around a caller-supplied
+// SchedulerVolumeBinder, bypassing the informer and args wiring New() does.
+// This lets out-of-tree scheduler builds reuse the stock VolumeBinding
+// plugin logic (Filter/Score/Reserve/PreBind/Unreserve) while plugging in
+// their own binder implementation, e.g. one backed by a different storage
+// control plane.
+//
+// NewWithOptions supersedes this for new callers: it additionally exposes
+// WithClock, and won't need a new positional parameter (and every existing
+// caller updated) the next time an optional dependency is added.
+func NewFromVolumeBinder(binder scheduling.SchedulerVolumeBinder, pvcLister corelisters.PersistentVolumeClaimLister, genericEphemeralVolumeFeatureEnabled bool, scorer volumeCapacityScorer) framework.Plugin {
+	return NewWithOptions(
+		WithBinder(binder),
+		WithPVCLister(pvcLister),
+		WithGenericEphemeralVolumeFeature(genericEphemeralVolumeFeatureEnabled),
+		WithCapacityScorer(scorer),
+	)
+}
+
+// New initializes a new plugin and returns it. plArgs must be a
+// *config.VolumeBindingArgs; this is the PluginFactory signature the
+// scheduler's plugin registry calls with whatever runtime.Object the config
+// loader produced for this plugin, already decoded. Callers that already
+// hold a typed *config.VolumeBindingArgs -- for example because they built
+// it programmatically, or are constructing a framework.Handle-based
+// component outside the registry -- should call NewFromArgs directly and
+// skip the type assertion.
 func New(plArgs runtime.Object, fh framework.Handle) (framework.Plugin, error) {
 	args, ok := plArgs.(*config.VolumeBindingArgs)
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type VolumeBindingArgs, got %T", plArgs)
 	}
+	return NewFromArgs(args, fh)
+}
+
+// NewFromArgs initializes a new plugin from a typed *config.VolumeBindingArgs
+// and framework.Handle. New delegates to this after asserting its
+// runtime.Object argument; this entry point is exposed so in-process callers
+// constructing the plugin around a typed args value don't need to round-trip
+// it through runtime.Object first.
+func NewFromArgs(args *config.VolumeBindingArgs, fh framework.Handle) (framework.Plugin, error) {
 	if err := validation.ValidateVolumeBindingArgs(nil, args); err != nil {
 		return nil, err
 	}
@@ -344,7 +969,22 @@ func New(plArgs runtime.Object, fh framework.Handle) (framework.Plugin, error) {
 			CSIStorageCapacityInformer: fh.SharedInformerFactory().Storage().V1beta1().CSIStorageCapacities(),
 		}
 	}
-	binder := scheduling.NewVolumeBinder(fh.ClientSet(), podInformer, nodeInformer, csiNodeInformer, pvcInformer, pvInformer, storageClassInformer, capacityCheck, time.Duration(args.BindTimeoutSeconds)*time.Second)
+	var crossSchedulerReservation *scheduling.CrossSchedulerReservation
+	if args.EnableCrossSchedulerReservation {
+		schedulerID, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("getting scheduler identity for cross-scheduler volume reservation: %w", err)
+		}
+		crossSchedulerReservation = &scheduling.CrossSchedulerReservation{
+			SchedulerID: schedulerID,
+			TTL:         crossSchedulerReservationTTL,
+		}
+	}
+	var capacityReservationPublisher *scheduling.CapacityReservationPublisher
+	if args.EnableCapacityReservationPublishing {
+		capacityReservationPublisher = &scheduling.CapacityReservationPublisher{}
+	}
+	binder := scheduling.NewVolumeBinder(fh.ClientSet(), podInformer, nodeInformer, csiNodeInformer, pvcInformer, pvInformer, storageClassInformer, capacityCheck, crossSchedulerReservation, capacityReservationPublisher, time.Duration(args.BindTimeoutSeconds)*time.Second)
 
 	// build score function
 	var scorer volumeCapacityScorer
@@ -358,10 +998,47 @@ func New(plArgs runtime.Object, fh framework.Handle) (framework.Plugin, error) {
 		}
 		scorer = buildScorerFunction(shape)
 	}
-	return &VolumeBinding{
-		Binder:                               binder,
-		PVCLister:                            pvcInformer.Lister(),
-		GenericEphemeralVolumeFeatureEnabled: utilfeature.DefaultFeatureGate.Enabled(features.GenericEphemeralVolume),
-		scorer:                               scorer,
-	}, nil
+	pl := NewFromVolumeBinder(binder, pvcInformer.Lister(), utilfeature.DefaultFeatureGate.Enabled(features.GenericEphemeralVolume), scorer).(*VolumeBinding)
+	pl.handle = fh
+	pl.PVLister = pvInformer.Lister()
+	if args.EnableBatchReservation {
+		pl.batchReservationEnabled = true
+		pl.batchCache = newBatchFilterCache(pl.clock)
+	}
+	pl.crossSchedulerReservationEnabled = args.EnableCrossSchedulerReservation
+	pl.capacityReservationPublishingEnabled = args.EnableCapacityReservationPublishing
+	pl.attachmentLimit = args.MaxAttachmentsPerVolume
+	return pl, nil
+}
+
+// UpdateArgs implements framework.UpdatablePlugin. It lets a running plugin
+// pick up a new BindTimeoutSeconds without the scheduler restarting, which
+// would otherwise drop in-flight assumed volume bindings (see
+// InFlightVolumeBinds). Other VolumeBindingArgs fields (Shape,
+// EnableBatchReservation, EnableCrossSchedulerReservation,
+// EnableCapacityReservationPublishing, MaxAttachmentsPerVolume) affect data
+// structures that aren't safe to swap out from under concurrent
+// Filter/Score calls, so changing them still requires a restart.
+func (pl *VolumeBinding) UpdateArgs(args runtime.Object) error {
+	newArgs, ok := args.(*config.VolumeBindingArgs)
+	if !ok {
+		return fmt.Errorf("want args to be of type VolumeBindingArgs, got %T", args)
+	}
+	if err := validation.ValidateVolumeBindingArgs(nil, newArgs); err != nil {
+		return err
+	}
+	if newArgs.EnableBatchReservation != pl.batchReservationEnabled {
+		return fmt.Errorf("EnableBatchReservation cannot be changed without restarting the scheduler")
+	}
+	if newArgs.EnableCrossSchedulerReservation != pl.crossSchedulerReservationEnabled {
+		return fmt.Errorf("EnableCrossSchedulerReservation cannot be changed without restarting the scheduler")
+	}
+	if newArgs.EnableCapacityReservationPublishing != pl.capacityReservationPublishingEnabled {
+		return fmt.Errorf("EnableCapacityReservationPublishing cannot be changed without restarting the scheduler")
+	}
+	if newArgs.MaxAttachmentsPerVolume != pl.attachmentLimit {
+		return fmt.Errorf("MaxAttachmentsPerVolume cannot be changed without restarting the scheduler")
+	}
+	pl.Binder.SetBindTimeout(time.Duration(newArgs.BindTimeoutSeconds) * time.Second)
+	return nil
 }
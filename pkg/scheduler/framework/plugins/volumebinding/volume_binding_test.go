@@ -18,8 +18,10 @@ package volumebinding
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -27,6 +29,8 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
@@ -38,6 +42,7 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/internal/cache"
 	"k8s.io/utils/pointer"
 )
 
@@ -152,6 +157,14 @@ func makePVC(name string, boundPVName string, storageClassName string) *v1.Persi
 	return pvc
 }
 
+func setPVCPreferredNode(pvc *v1.PersistentVolumeClaim, nodeName string, required bool) *v1.PersistentVolumeClaim {
+	metav1.SetMetaDataAnnotation(&pvc.ObjectMeta, pvutil.AnnPreferredNode, nodeName)
+	if required {
+		metav1.SetMetaDataAnnotation(&pvc.ObjectMeta, pvutil.AnnRequiredNode, "true")
+	}
+	return pvc
+}
+
 func setPVCRequestStorage(pvc *v1.PersistentVolumeClaim, request resource.Quantity) *v1.PersistentVolumeClaim {
 	pvc.Spec.Resources = v1.ResourceRequirements{
 		Requests: v1.ResourceList{
@@ -303,7 +316,7 @@ func TestVolumeBinding(t *testing.T) {
 				podVolumesByNode: map[string]*scheduling.PodVolumes{},
 			},
 			wantFilterStatus: []*framework.Status{
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, string(scheduling.ErrReasonBindConflict)),
+				framework.NewStatus(framework.Unschedulable, string(scheduling.ErrReasonBindConflict)),
 			},
 			wantScores: []int64{
 				0,
@@ -409,7 +422,7 @@ func TestVolumeBinding(t *testing.T) {
 			wantFilterStatus: []*framework.Status{
 				nil,
 				nil,
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
 			},
 			wantScores: []int64{
 				25,
@@ -452,7 +465,7 @@ func TestVolumeBinding(t *testing.T) {
 			wantFilterStatus: []*framework.Status{
 				nil,
 				nil,
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
 			},
 			wantScores: []int64{
 				38,
@@ -524,8 +537,8 @@ func TestVolumeBinding(t *testing.T) {
 				nil,
 				nil,
 				nil,
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
 			},
 			wantScores: []int64{
 				25,
@@ -617,8 +630,8 @@ func TestVolumeBinding(t *testing.T) {
 				nil,
 				nil,
 				nil,
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
-				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
+				framework.NewStatus(framework.Unschedulable, `node(s) didn't find available persistent volumes to bind`),
 			},
 			wantScores: []int64{
 				15,
@@ -629,6 +642,58 @@ func TestVolumeBinding(t *testing.T) {
 				0,
 			},
 		},
+		{
+			name: "preferred node hint scores the hinted node highest",
+			pod:  makePod("pod-a", []string{"pvc-a"}),
+			nodes: []*v1.Node{
+				makeNode("node-a"),
+				makeNode("node-b"),
+			},
+			pvcs: []*v1.PersistentVolumeClaim{
+				setPVCPreferredNode(makePVC("pvc-a", "", waitSC.Name), "node-b", false),
+			},
+			wantStateAfterPreFilter: &stateData{
+				boundClaims: []*v1.PersistentVolumeClaim{},
+				claimsToBind: []*v1.PersistentVolumeClaim{
+					setPVCPreferredNode(makePVC("pvc-a", "", waitSC.Name), "node-b", false),
+				},
+				podVolumesByNode: map[string]*scheduling.PodVolumes{},
+			},
+			wantFilterStatus: []*framework.Status{
+				framework.NewStatus(framework.Unschedulable, string(scheduling.ErrReasonBindConflict)),
+				framework.NewStatus(framework.Unschedulable, string(scheduling.ErrReasonBindConflict)),
+			},
+			wantScores: []int64{
+				0,
+				framework.MaxNodeScore,
+			},
+		},
+		{
+			name: "required node hint rejects every other node",
+			pod:  makePod("pod-a", []string{"pvc-a"}),
+			nodes: []*v1.Node{
+				makeNode("node-a"),
+				makeNode("node-b"),
+			},
+			pvcs: []*v1.PersistentVolumeClaim{
+				setPVCPreferredNode(makePVC("pvc-a", "", waitSC.Name), "node-b", true),
+			},
+			wantStateAfterPreFilter: &stateData{
+				boundClaims: []*v1.PersistentVolumeClaim{},
+				claimsToBind: []*v1.PersistentVolumeClaim{
+					setPVCPreferredNode(makePVC("pvc-a", "", waitSC.Name), "node-b", true),
+				},
+				podVolumesByNode: map[string]*scheduling.PodVolumes{},
+			},
+			wantFilterStatus: []*framework.Status{
+				framework.NewStatus(framework.UnschedulableAndUnresolvable, `node does not match the node required by persistentvolumeclaim default/pvc-a`),
+				framework.NewStatus(framework.Unschedulable, string(scheduling.ErrReasonBindConflict)),
+			},
+			wantScores: []int64{
+				0,
+				framework.MaxNodeScore,
+			},
+		},
 	}
 
 	for _, item := range table {
@@ -720,10 +785,18 @@ func TestVolumeBinding(t *testing.T) {
 			}
 
 			t.Logf("Verify: call Filter and check status")
+			// Filter also attaches structured diagnostics alongside the
+			// reasons asserted below; that's covered separately by
+			// TestFilterDiagnostics, so ignore it here to keep this table
+			// focused on the reasons/code contract.
+			statusCmpOpts := []cmp.Option{
+				cmp.AllowUnexported(framework.Status{}),
+				cmpopts.IgnoreFields(framework.Status{}, "diagnostics"),
+			}
 			for i, nodeInfo := range nodeInfos {
 				gotStatus := p.Filter(ctx, state, item.pod, nodeInfo)
-				if !reflect.DeepEqual(gotStatus, item.wantFilterStatus[i]) {
-					t.Errorf("filter status does not match for node %q, got: %v, want: %v", nodeInfo.Node().Name, gotStatus, item.wantFilterStatus)
+				if diff := cmp.Diff(item.wantFilterStatus[i], gotStatus, statusCmpOpts...); diff != "" {
+					t.Errorf("filter status does not match for node %q (-want,+got):\n%s", nodeInfo.Node().Name, diff)
 				}
 			}
 
@@ -740,3 +813,406 @@ func TestVolumeBinding(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchFilterCache(t *testing.T) {
+	owner := types.UID("owner-1")
+	status := framework.NewStatus(framework.UnschedulableAndUnresolvable, "no matching PVs")
+	key1 := batchCacheKey{nodeName: "node-1", claimsKey: "pvc-uid-1"}
+
+	c := newBatchFilterCache(clock.RealClock{})
+	if _, ok := c.get(owner, key1); ok {
+		t.Fatalf("expected no cached entry before record")
+	}
+
+	c.record(owner, key1, status)
+	got, ok := c.get(owner, key1)
+	if !ok || got != status {
+		t.Fatalf("expected cached status %v, got %v (ok=%v)", status, got, ok)
+	}
+
+	if _, ok := c.get(owner, batchCacheKey{nodeName: "node-2", claimsKey: "pvc-uid-1"}); ok {
+		t.Fatalf("expected no cached entry for a different node")
+	}
+	if _, ok := c.get(types.UID("owner-2"), key1); ok {
+		t.Fatalf("expected no cached entry for a different owner")
+	}
+	if _, ok := c.get(owner, batchCacheKey{nodeName: "node-1", claimsKey: "pvc-uid-2"}); ok {
+		t.Fatalf("expected no cached entry for a different claim state sharing the same owner and node")
+	}
+
+	c.entries[owner][key1] = batchCacheEntry{status: status, expires: time.Now().Add(-time.Second)}
+	if _, ok := c.get(owner, key1); ok {
+		t.Fatalf("expected expired entry to be treated as a cache miss")
+	}
+}
+
+// TestFilterBatchCacheDoesNotCrossContaminateSiblingClaims drives Filter for
+// two pods owned by the same controller (e.g. StatefulSet replicas), each
+// with its own claimsToBind. It guards against the cache keying only on
+// (owner, nodeName): pod-0's infeasible result for its PVC must never be
+// replayed for pod-1, whose independent PVC is feasible on the same node.
+func TestFilterBatchCacheDoesNotCrossContaminateSiblingClaims(t *testing.T) {
+	owner := &metav1.OwnerReference{UID: types.UID("sts-uid"), Controller: pointer.BoolPtr(true)}
+	pod0 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "sts-0", OwnerReferences: []metav1.OwnerReference{*owner}}}
+	pod1 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "sts-1", OwnerReferences: []metav1.OwnerReference{*owner}}}
+	claim0 := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "data-sts-0", UID: types.UID("pvc-0")}}
+	claim1 := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "data-sts-1", UID: types.UID("pvc-1")}}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	// infeasibleClaimBinder rejects only a named claim, so distinct claims
+	// can have distinct FindPodVolumes outcomes on the same node.
+	binder := &infeasibleClaimBinder{
+		FakeVolumeBinder: scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}),
+		infeasibleClaim:  "data-sts-0",
+	}
+	pl := NewWithOptions(WithBinder(binder)).(*VolumeBinding)
+	pl.batchReservationEnabled = true
+	pl.batchCache = newBatchFilterCache(pl.clock)
+
+	ctx := context.Background()
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(node)
+	state0 := framework.NewCycleState()
+	state0.Write(stateKey, &stateData{claimsToBind: []*v1.PersistentVolumeClaim{claim0}, podVolumesByNode: map[string]*scheduling.PodVolumes{}})
+	status0 := pl.Filter(ctx, state0, pod0, nodeInfo)
+	if status0.IsSuccess() {
+		t.Fatalf("expected pod0 to be rejected on node-1, got success")
+	}
+
+	state1 := framework.NewCycleState()
+	state1.Write(stateKey, &stateData{claimsToBind: []*v1.PersistentVolumeClaim{claim1}, podVolumesByNode: map[string]*scheduling.PodVolumes{}})
+	status1 := pl.Filter(ctx, state1, pod1, nodeInfo)
+	if !status1.IsSuccess() {
+		t.Fatalf("expected pod1's independent, feasible claim not to be rejected by pod0's cached result, got: %v", status1)
+	}
+}
+
+// infeasibleClaimBinder wraps FakeVolumeBinder to report a single named
+// claim as infeasible while leaving every other claim feasible, so tests
+// can exercise Filter with pods whose claims diverge in feasibility.
+type infeasibleClaimBinder struct {
+	*scheduling.FakeVolumeBinder
+	infeasibleClaim string
+}
+
+func (b *infeasibleClaimBinder) FindPodVolumes(pod *v1.Pod, boundClaims, claimsToBind []*v1.PersistentVolumeClaim, node *v1.Node) (*scheduling.PodVolumes, scheduling.ConflictReasons, error) {
+	for _, claim := range claimsToBind {
+		if claim.Name == b.infeasibleClaim {
+			return nil, scheduling.ConflictReasons{scheduling.ErrReasonNodeConflict}, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+func TestInFlightVolumeBinds(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod-1", UID: types.UID("pod-1-uid")}}
+	pvc := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "dynamic-pvc"}}
+	podVolumes := &scheduling.PodVolumes{DynamicProvisions: []*v1.PersistentVolumeClaim{pvc}}
+
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}), nil, false, nil).(*VolumeBinding)
+
+	if got := pl.InFlightVolumeBinds(); len(got) != 0 {
+		t.Fatalf("expected no in-flight binds before PreBind, got %v", got)
+	}
+
+	cs := framework.NewCycleState()
+	cs.Write(stateKey, &stateData{podVolumesByNode: map[string]*scheduling.PodVolumes{"node-1": podVolumes}})
+
+	if status := pl.PreBind(context.Background(), cs, pod, "node-1"); !status.IsSuccess() {
+		t.Fatalf("PreBind failed: %v", status)
+	}
+
+	if got := pl.InFlightVolumeBinds(); len(got) != 0 {
+		t.Fatalf("expected in-flight entry to be cleared once PreBind returns, got %v", got)
+	}
+}
+
+func TestPreBindAnnotatesPinnedNode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod-1"}}
+	client := fake.NewSimpleClientset(pod)
+	fh, err := runtime.NewFramework(nil, nil, runtime.WithClientSet(client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}), nil, false, nil).(*VolumeBinding)
+	pl.handle = fh
+
+	cs := framework.NewCycleState()
+	cs.Write(stateKey, &stateData{nominatedNodeName: "node-1", allBound: true, podVolumesByNode: map[string]*scheduling.PodVolumes{}})
+
+	if status := pl.PreBind(ctx, cs, pod, "node-1"); !status.IsSuccess() {
+		t.Fatalf("PreBind failed: %v", status)
+	}
+
+	got, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "node-1"; got.Annotations[PodPinnedByVolumeAnnotation] != want {
+		t.Errorf("pod annotation %q = %q, want %q", PodPinnedByVolumeAnnotation, got.Annotations[PodPinnedByVolumeAnnotation], want)
+	}
+}
+
+func TestPreBindClaimDeletedIsUnschedulable(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod-1"}}
+	podVolumes := &scheduling.PodVolumes{}
+
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{
+		BindErr: fmt.Errorf("binding volumes: %w", &scheduling.ClaimDeletedError{ClaimKey: "ns/pvc-1"}),
+	}), nil, false, nil).(*VolumeBinding)
+
+	cs := framework.NewCycleState()
+	cs.Write(stateKey, &stateData{podVolumesByNode: map[string]*scheduling.PodVolumes{"node-1": podVolumes}})
+
+	status := pl.PreBind(context.Background(), cs, pod, "node-1")
+	if status.Code() != framework.UnschedulableAndUnresolvable {
+		t.Fatalf("got status code %v, want %v", status.Code(), framework.UnschedulableAndUnresolvable)
+	}
+}
+
+// TestAttachmentLimit exercises checkAttachmentLimit/trackAssumedAttachments
+// directly: the scenario they exist for is a shared (e.g. RWX) PV already
+// bound to several pods' claims, so boundClaims rather than podVolumes is
+// what drives which PV each call is about.
+func TestAttachmentLimit(t *testing.T) {
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}), nil, false, nil).(*VolumeBinding)
+	pl.attachmentLimit = 2
+
+	sharedClaim := []*v1.PersistentVolumeClaim{makePVC("pvc-a", "pv-shared", waitSC.Name)}
+	podVolumes := &scheduling.PodVolumes{}
+
+	if status := pl.checkAttachmentLimit("node-a", sharedClaim, podVolumes); status != nil {
+		t.Fatalf("node-a unexpectedly rejected: %v", status)
+	}
+	pl.trackAssumedAttachments("node-a", sharedClaim, podVolumes, true)
+
+	if status := pl.checkAttachmentLimit("node-b", sharedClaim, podVolumes); status != nil {
+		t.Fatalf("node-b unexpectedly rejected: %v", status)
+	}
+	pl.trackAssumedAttachments("node-b", sharedClaim, podVolumes, true)
+
+	if status := pl.checkAttachmentLimit("node-c", sharedClaim, podVolumes); status == nil {
+		t.Fatal("expected node-c to be rejected for exceeding the attachment limit")
+	}
+
+	// A second pod reusing node-a's existing attachment must still be allowed.
+	if status := pl.checkAttachmentLimit("node-a", sharedClaim, podVolumes); status != nil {
+		t.Fatalf("node-a unexpectedly rejected for a pod reusing its existing attachment: %v", status)
+	}
+	pl.trackAssumedAttachments("node-a", sharedClaim, podVolumes, true)
+
+	// Releasing one of the two pods assumed on node-a must not free it up yet.
+	pl.trackAssumedAttachments("node-a", sharedClaim, podVolumes, false)
+	if status := pl.checkAttachmentLimit("node-c", sharedClaim, podVolumes); status == nil {
+		t.Fatal("expected node-c to still be rejected while node-a has a remaining attachment")
+	}
+
+	// Releasing the last pod on node-a frees it up for node-c.
+	pl.trackAssumedAttachments("node-a", sharedClaim, podVolumes, false)
+	if status := pl.checkAttachmentLimit("node-c", sharedClaim, podVolumes); status != nil {
+		t.Fatalf("node-c unexpectedly still rejected after node-a's attachment was released: %v", status)
+	}
+}
+
+// TestAttachmentLimitDisabled verifies that a zero attachmentLimit (the
+// default) never rejects a node, regardless of how many attachments have
+// been tracked.
+func TestAttachmentLimitDisabled(t *testing.T) {
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}), nil, false, nil).(*VolumeBinding)
+
+	sharedClaim := []*v1.PersistentVolumeClaim{makePVC("pvc-a", "pv-shared", waitSC.Name)}
+	podVolumes := &scheduling.PodVolumes{}
+	for _, node := range []string{"node-a", "node-b", "node-c"} {
+		if status := pl.checkAttachmentLimit(node, sharedClaim, podVolumes); status != nil {
+			t.Fatalf("node %q unexpectedly rejected with the attachment limit disabled: %v", node, status)
+		}
+		pl.trackAssumedAttachments(node, sharedClaim, podVolumes, true)
+	}
+	if len(pl.assumedAttachments) != 0 {
+		t.Errorf("assumedAttachments = %v, want empty while the limit is disabled", pl.assumedAttachments)
+	}
+}
+
+func TestPendingPVCNames(t *testing.T) {
+	dynamicPVC := &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "dynamic-pvc"}}
+	podVolumes := &scheduling.PodVolumes{DynamicProvisions: []*v1.PersistentVolumeClaim{dynamicPVC}}
+
+	got := pendingPVCNames(podVolumes)
+	want := []string{"dynamic-pvc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pendingPVCNames() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterDiagnostics(t *testing.T) {
+	claimA := makePVC("pvc-a", "", waitSC.Name)
+	claimB := makePVC("pvc-b", "", waitSC.Name)
+
+	pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{
+		FindReasons: scheduling.ConflictReasons{scheduling.ErrReasonNodeConflict, scheduling.ErrReasonBindConflict},
+	}), nil, false, nil).(*VolumeBinding)
+
+	cs := framework.NewCycleState()
+	cs.Write(stateKey, &stateData{claimsToBind: []*v1.PersistentVolumeClaim{claimA, claimB}, podVolumesByNode: map[string]*scheduling.PodVolumes{}})
+
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(makeNode("node-a"))
+	status := pl.Filter(context.Background(), cs, makePod("pod-a", []string{"pvc-a", "pvc-b"}), nodeInfo)
+
+	want := []framework.Diagnostic{
+		{Key: "persistentvolumeclaim/default/pvc-a", Value: string(scheduling.ErrReasonNodeConflict)},
+		{Key: "persistentvolumeclaim/default/pvc-a", Value: string(scheduling.ErrReasonBindConflict)},
+		{Key: "persistentvolumeclaim/default/pvc-b", Value: string(scheduling.ErrReasonNodeConflict)},
+		{Key: "persistentvolumeclaim/default/pvc-b", Value: string(scheduling.ErrReasonBindConflict)},
+	}
+	if diff := cmp.Diff(want, status.Diagnostics()); diff != "" {
+		t.Errorf("Filter() diagnostics mismatch (-want,+got):\n%s", diff)
+	}
+}
+
+func TestNominateNodeFromBoundPVs(t *testing.T) {
+	nodeA := makeNode("node-a")
+	nodeB := makeNode("node-b")
+	pvSingleNode := setPVNodeAffinity(makePV("pv-a", waitSC.Name), map[string][]string{v1.LabelHostname: {"node-a"}})
+	pvEveryNode := makePV("pv-any", waitSC.Name)
+	boundToSingleNode := makePVC("pvc-a", pvSingleNode.Name, waitSC.Name)
+	boundToEveryNode := makePVC("pvc-any", pvEveryNode.Name, waitSC.Name)
+
+	tests := map[string]struct {
+		nodes       []*v1.Node
+		pvs         []*v1.PersistentVolume
+		boundClaims []*v1.PersistentVolumeClaim
+		want        string
+	}{
+		"single node admitted": {
+			nodes:       []*v1.Node{nodeA, nodeB},
+			pvs:         []*v1.PersistentVolume{pvSingleNode},
+			boundClaims: []*v1.PersistentVolumeClaim{boundToSingleNode},
+			want:        "node-a",
+		},
+		"every node admitted": {
+			nodes:       []*v1.Node{nodeA, nodeB},
+			pvs:         []*v1.PersistentVolume{pvEveryNode},
+			boundClaims: []*v1.PersistentVolumeClaim{boundToEveryNode},
+			want:        "",
+		},
+		"no bound claims": {
+			nodes: []*v1.Node{nodeA, nodeB},
+			pvs:   []*v1.PersistentVolume{pvSingleNode},
+			want:  "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := fake.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(client, 0)
+			pvLister := informerFactory.Core().V1().PersistentVolumes().Lister()
+			for _, pv := range test.pvs {
+				client.CoreV1().PersistentVolumes().Create(ctx, pv, metav1.CreateOptions{})
+			}
+			informerFactory.Start(ctx.Done())
+			informerFactory.WaitForCacheSync(ctx.Done())
+
+			fh, err := runtime.NewFramework(nil, nil, runtime.WithSnapshotSharedLister(cache.NewSnapshot(nil, test.nodes)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			pl := NewFromVolumeBinder(scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{}), nil, false, nil).(*VolumeBinding)
+			pl.handle = fh
+			pl.PVLister = pvLister
+
+			if got := pl.nominateNodeFromBoundPVs(test.boundClaims); got != test.want {
+				t.Errorf("nominateNodeFromBoundPVs() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUpdateArgs(t *testing.T) {
+	fakeVolumeBinder := scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{})
+	pl := NewFromVolumeBinder(fakeVolumeBinder, nil, false, nil).(*VolumeBinding)
+
+	if err := pl.UpdateArgs(&config.VolumeBindingArgs{BindTimeoutSeconds: 300}); err != nil {
+		t.Fatalf("UpdateArgs failed: %v", err)
+	}
+	if fakeVolumeBinder.BindTimeout != 300*time.Second {
+		t.Errorf("BindTimeout = %v, want %v", fakeVolumeBinder.BindTimeout, 300*time.Second)
+	}
+
+	if err := pl.UpdateArgs(&config.VolumeBindingArgs{BindTimeoutSeconds: -1}); err == nil {
+		t.Error("UpdateArgs with an invalid BindTimeoutSeconds should have failed validation")
+	}
+
+	pl.batchReservationEnabled = true
+	if err := pl.UpdateArgs(&config.VolumeBindingArgs{BindTimeoutSeconds: 300, EnableBatchReservation: false}); err == nil {
+		t.Error("UpdateArgs should reject changing EnableBatchReservation without a restart")
+	}
+	pl.batchReservationEnabled = false
+
+	pl.crossSchedulerReservationEnabled = true
+	if err := pl.UpdateArgs(&config.VolumeBindingArgs{BindTimeoutSeconds: 300, EnableCrossSchedulerReservation: false}); err == nil {
+		t.Error("UpdateArgs should reject changing EnableCrossSchedulerReservation without a restart")
+	}
+	pl.crossSchedulerReservationEnabled = false
+
+	pl.attachmentLimit = 2
+	if err := pl.UpdateArgs(&config.VolumeBindingArgs{BindTimeoutSeconds: 300, MaxAttachmentsPerVolume: 3}); err == nil {
+		t.Error("UpdateArgs should reject changing MaxAttachmentsPerVolume without a restart")
+	}
+
+	if err := pl.UpdateArgs(&v1.Pod{}); err == nil {
+		t.Error("UpdateArgs should reject args of the wrong type")
+	}
+}
+
+// TestNewWithOptions verifies that NewWithOptions wires each Option into the
+// resulting plugin, and that NewFromVolumeBinder (implemented on top of it)
+// still produces an equivalent plugin from its positional arguments.
+func TestNewWithOptions(t *testing.T) {
+	binder := scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{})
+	pvcLister := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Core().V1().PersistentVolumeClaims().Lister()
+	fakeClock := clock.NewFakeClock(time.Now())
+	scorer := volumeCapacityScorer(func(classResourceMap) int64 { return 1 })
+
+	pl := NewWithOptions(
+		WithBinder(binder),
+		WithPVCLister(pvcLister),
+		WithGenericEphemeralVolumeFeature(true),
+		WithClock(fakeClock),
+	).(*VolumeBinding)
+
+	if pl.Binder != binder {
+		t.Error("WithBinder did not set Binder")
+	}
+	if pl.PVCLister != pvcLister {
+		t.Error("WithPVCLister did not set PVCLister")
+	}
+	if !pl.GenericEphemeralVolumeFeatureEnabled {
+		t.Error("WithGenericEphemeralVolumeFeature did not set GenericEphemeralVolumeFeatureEnabled")
+	}
+	if pl.clock != fakeClock {
+		t.Error("WithClock did not set clock")
+	}
+	if pl.scorer != nil {
+		t.Error("expected scorer to be unset without WithCapacityScorer")
+	}
+
+	pl = NewWithOptions(WithCapacityScorer(scorer)).(*VolumeBinding)
+	if pl.scorer == nil {
+		t.Error("WithCapacityScorer did not set scorer")
+	}
+	if pl.clock == nil {
+		t.Error("expected NewWithOptions to default clock to the real clock")
+	}
+
+	fromVolumeBinder := NewFromVolumeBinder(binder, pvcLister, true, scorer).(*VolumeBinding)
+	if fromVolumeBinder.Binder != binder || fromVolumeBinder.PVCLister != pvcLister || !fromVolumeBinder.GenericEphemeralVolumeFeatureEnabled || fromVolumeBinder.scorer == nil {
+		t.Error("NewFromVolumeBinder did not set all fields from its positional arguments")
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/component-helpers/scheduling/corev1"
 	"k8s.io/klog/v2"
@@ -92,9 +94,22 @@ type frameworkImpl struct {
 	eventRecorder   events.EventRecorder
 	informerFactory informers.SharedInformerFactory
 
+	// eventHandlersRegistered tracks which keys have already had a handler
+	// registered via RegisterEventHandler, so plugins sharing this Handle
+	// don't each add their own copy of the same handler.
+	eventHandlersMu         sync.Mutex
+	eventHandlersRegistered sets.String
+
 	metricsRecorder *metricsRecorder
 	profileName     string
 
+	// pluginsMap holds every plugin instance configured for this profile,
+	// keyed by name, regardless of which extension points it implements.
+	// It backs the Plugin accessor used by debug/introspection tooling that
+	// needs to reach a specific plugin outside the normal extension-point
+	// invocation paths.
+	pluginsMap map[string]framework.Plugin
+
 	extenders []framework.Extender
 	framework.PodNominator
 
@@ -103,6 +118,11 @@ type frameworkImpl struct {
 	// Indicates that RunFilterPlugins should accumulate all failed statuses and not return
 	// after the first failure.
 	runAllFilters bool
+
+	// preBindPluginTimeout bounds how long a single PreBind plugin call may
+	// run. Zero means no per-plugin deadline is enforced, leaving the overall
+	// binding cycle timeout (if any) as the only bound.
+	preBindPluginTimeout time.Duration
 }
 
 // extensionPoint encapsulates desired and applied set of plugins at a specific extension
@@ -151,6 +171,7 @@ type frameworkOptions struct {
 	captureProfile         CaptureProfile
 	clusterEventMap        map[framework.ClusterEvent]sets.String
 	parallelizer           parallelize.Parallelizer
+	preBindPluginTimeout   time.Duration
 }
 
 // Option for the frameworkImpl.
@@ -230,6 +251,16 @@ func WithParallelism(parallelism int) Option {
 	}
 }
 
+// WithPreBindPluginTimeout bounds how long a single PreBind plugin call may
+// run before its context is cancelled. This guards against a slow plugin
+// (e.g. one waiting on external volume binding) holding a binding cycle open
+// indefinitely. The default, zero, enforces no per-plugin deadline.
+func WithPreBindPluginTimeout(timeout time.Duration) Option {
+	return func(o *frameworkOptions) {
+		o.preBindPluginTimeout = timeout
+	}
+}
+
 // CaptureProfile is a callback to capture a finalized profile.
 type CaptureProfile func(config.KubeSchedulerProfile)
 
@@ -278,6 +309,9 @@ func NewFramework(r Registry, profile *config.KubeSchedulerProfile, opts ...Opti
 		extenders:            options.extenders,
 		PodNominator:         options.podNominator,
 		parallelizer:         options.parallelizer,
+		preBindPluginTimeout: options.preBindPluginTimeout,
+
+		eventHandlersRegistered: sets.NewString(),
 	}
 
 	if profile == nil {
@@ -351,6 +385,7 @@ func NewFramework(r Registry, profile *config.KubeSchedulerProfile, opts ...Opti
 			return nil, err
 		}
 	}
+	f.pluginsMap = pluginsMap
 
 	// Verifying the score weights again since Plugin.Name() could return a different
 	// value from the one used in the configuration.
@@ -868,6 +903,11 @@ func (f *frameworkImpl) RunPreBindPlugins(ctx context.Context, state *framework.
 }
 
 func (f *frameworkImpl) runPreBindPlugin(ctx context.Context, pl framework.PreBindPlugin, state *framework.CycleState, pod *v1.Pod, nodeName string) *framework.Status {
+	if f.preBindPluginTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.preBindPluginTimeout)
+		defer cancel()
+	}
 	if !state.ShouldRecordPluginMetrics() {
 		return pl.PreBind(ctx, state, pod, nodeName)
 	}
@@ -932,6 +972,23 @@ func (f *frameworkImpl) runPostBindPlugin(ctx context.Context, pl framework.Post
 	f.metricsRecorder.observePluginDurationAsync(postBind, pl.Name(), nil, metrics.SinceInSeconds(startTime))
 }
 
+// reservedPluginsStateKey is used to remember, per scheduling cycle, which
+// reserve plugins actually succeeded their Reserve call so that
+// RunReservePluginsUnreserve can clean up only those plugins, in reverse
+// order.
+const reservedPluginsStateKey framework.StateKey = "framework/reservedReservePlugins"
+
+// reservedPluginsStateData holds the names of the reserve plugins that
+// successfully reserved resources for the pod in this cycle, in the order
+// their Reserve call returned success.
+type reservedPluginsStateData struct {
+	names []string
+}
+
+func (s *reservedPluginsStateData) Clone() framework.StateData {
+	return s
+}
+
 // RunReservePluginsReserve runs the Reserve method in the set of configured
 // reserve plugins. If any of these plugins returns an error, it does not
 // continue running the remaining ones and returns the error. In such a case,
@@ -942,6 +999,8 @@ func (f *frameworkImpl) RunReservePluginsReserve(ctx context.Context, state *fra
 	defer func() {
 		metrics.FrameworkExtensionPointDuration.WithLabelValues(reserve, status.Code().String(), f.profileName).Observe(metrics.SinceInSeconds(startTime))
 	}()
+	reserved := &reservedPluginsStateData{}
+	defer state.Write(reservedPluginsStateKey, reserved)
 	for _, pl := range f.reservePlugins {
 		status = f.runReservePluginReserve(ctx, pl, state, pod, nodeName)
 		if !status.IsSuccess() {
@@ -949,6 +1008,7 @@ func (f *frameworkImpl) RunReservePluginsReserve(ctx context.Context, state *fra
 			klog.ErrorS(err, "Failed running Reserve plugin", "plugin", pl.Name(), "pod", klog.KObj(pod))
 			return framework.AsStatus(fmt.Errorf("running Reserve plugin %q: %w", pl.Name(), err))
 		}
+		reserved.names = append(reserved.names, pl.Name())
 	}
 	return nil
 }
@@ -970,11 +1030,50 @@ func (f *frameworkImpl) RunReservePluginsUnreserve(ctx context.Context, state *f
 	defer func() {
 		metrics.FrameworkExtensionPointDuration.WithLabelValues(unreserve, framework.Success.String(), f.profileName).Observe(metrics.SinceInSeconds(startTime))
 	}()
-	// Execute the Unreserve operation of each reserve plugin in the
-	// *reverse* order in which the Reserve operation was executed.
-	for i := len(f.reservePlugins) - 1; i >= 0; i-- {
-		f.runReservePluginUnreserve(ctx, f.reservePlugins[i], state, pod, nodeName)
+	// Only Unreserve the plugins that actually succeeded their Reserve call
+	// for this pod, and do so in the *reverse* order in which Reserve was
+	// executed, so that a plugin reserving a more fundamental resource (e.g.
+	// volumes) is released only after plugins that reserved resources
+	// depending on it (e.g. node capacity).
+	for _, pl := range f.reservedReservePlugins(state) {
+		f.runReservePluginUnreserve(ctx, pl, state, pod, nodeName)
+	}
+}
+
+// reservedReservePlugins returns the reserve plugins that successfully
+// reserved resources for this cycle's pod, in reverse order of execution. If
+// state does not carry that bookkeeping (e.g. RunReservePluginsReserve was
+// never called, as can happen in tests), it conservatively falls back to all
+// configured reserve plugins so every plugin still gets a chance to clean up
+// per their own idempotency contract.
+func (f *frameworkImpl) reservedReservePlugins(state *framework.CycleState) []framework.ReservePlugin {
+	value, err := state.Read(reservedPluginsStateKey)
+	if err != nil {
+		return reverseReservePlugins(f.reservePlugins)
+	}
+	reserved, ok := value.(*reservedPluginsStateData)
+	if !ok {
+		return reverseReservePlugins(f.reservePlugins)
+	}
+	byName := make(map[string]framework.ReservePlugin, len(f.reservePlugins))
+	for _, pl := range f.reservePlugins {
+		byName[pl.Name()] = pl
 	}
+	ordered := make([]framework.ReservePlugin, 0, len(reserved.names))
+	for _, name := range reserved.names {
+		if pl, ok := byName[name]; ok {
+			ordered = append(ordered, pl)
+		}
+	}
+	return reverseReservePlugins(ordered)
+}
+
+func reverseReservePlugins(plugins []framework.ReservePlugin) []framework.ReservePlugin {
+	reversed := make([]framework.ReservePlugin, len(plugins))
+	for i, pl := range plugins {
+		reversed[len(plugins)-1-i] = pl
+	}
+	return reversed
 }
 
 func (f *frameworkImpl) runReservePluginUnreserve(ctx context.Context, pl framework.ReservePlugin, state *framework.CycleState, pod *v1.Pod, nodeName string) {
@@ -1139,6 +1238,27 @@ func (f *frameworkImpl) ListPlugins() *config.Plugins {
 	return &m
 }
 
+// Plugin returns the plugin instance configured under name for this
+// profile, regardless of which extension points it implements.
+func (f *frameworkImpl) Plugin(name string) (framework.Plugin, bool) {
+	p, ok := f.pluginsMap[name]
+	return p, ok
+}
+
+// UpdatePluginArgs applies args to the plugin configured under name, if that
+// plugin implements framework.UpdatablePlugin.
+func (f *frameworkImpl) UpdatePluginArgs(name string, args runtime.Object) error {
+	p, ok := f.pluginsMap[name]
+	if !ok {
+		return fmt.Errorf("plugin %q is not configured", name)
+	}
+	updatable, ok := p.(framework.UpdatablePlugin)
+	if !ok {
+		return fmt.Errorf("plugin %q does not support updating args without a restart", name)
+	}
+	return updatable.UpdateArgs(args)
+}
+
 // ClientSet returns a kubernetes clientset.
 func (f *frameworkImpl) ClientSet() clientset.Interface {
 	return f.clientSet
@@ -1159,6 +1279,18 @@ func (f *frameworkImpl) SharedInformerFactory() informers.SharedInformerFactory
 	return f.informerFactory
 }
 
+// RegisterEventHandler implements framework.Handle. See its doc for details.
+func (f *frameworkImpl) RegisterEventHandler(key string, informer cache.SharedIndexInformer, handler cache.ResourceEventHandler) bool {
+	f.eventHandlersMu.Lock()
+	defer f.eventHandlersMu.Unlock()
+	if f.eventHandlersRegistered.Has(key) {
+		return false
+	}
+	informer.AddEventHandler(handler)
+	f.eventHandlersRegistered.Insert(key)
+	return true
+}
+
 func (f *frameworkImpl) pluginsNeeded(plugins *config.Plugins) map[string]config.Plugin {
 	pgMap := make(map[string]config.Plugin)
 
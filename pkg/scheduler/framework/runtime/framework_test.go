@@ -31,6 +31,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/component-base/metrics/testutil"
 	"k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
@@ -1489,6 +1492,55 @@ func TestPreBindPlugins(t *testing.T) {
 	}
 }
 
+// deadlinePreBindPlugin records whether its PreBind call observed a context
+// deadline, to verify WithPreBindPluginTimeout is applied per call.
+type deadlinePreBindPlugin struct {
+	name       string
+	sawDealine bool
+}
+
+func (pl *deadlinePreBindPlugin) Name() string { return pl.name }
+
+func (pl *deadlinePreBindPlugin) PreBind(ctx context.Context, _ *framework.CycleState, _ *v1.Pod, _ string) *framework.Status {
+	_, pl.sawDealine = ctx.Deadline()
+	return nil
+}
+
+func TestPreBindPluginTimeout(t *testing.T) {
+	pl := &deadlinePreBindPlugin{name: "deadlinePreBindPlugin"}
+	registry := Registry{}
+	if err := registry.Register(pl.name, func(_ runtime.Object, _ framework.Handle) (framework.Plugin, error) {
+		return pl, nil
+	}); err != nil {
+		t.Fatalf("Unable to register the PreBind plugin: %s", err)
+	}
+	configPlugins := &config.Plugins{PreBind: config.PluginSet{Enabled: []config.Plugin{{Name: pl.name}}}}
+	profile := config.KubeSchedulerProfile{Plugins: configPlugins}
+
+	f, err := newFrameworkWithQueueSortAndBind(registry, profile, WithPreBindPluginTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("fail to create framework: %s", err)
+	}
+	if status := f.RunPreBindPlugins(context.Background(), nil, pod, ""); !status.IsSuccess() {
+		t.Fatalf("RunPreBindPlugins failed: %v", status)
+	}
+	if !pl.sawDealine {
+		t.Error("expected the PreBind plugin to observe a context deadline, got none")
+	}
+
+	pl.sawDealine = false
+	fNoTimeout, err := newFrameworkWithQueueSortAndBind(registry, profile)
+	if err != nil {
+		t.Fatalf("fail to create framework: %s", err)
+	}
+	if status := fNoTimeout.RunPreBindPlugins(context.Background(), nil, pod, ""); !status.IsSuccess() {
+		t.Fatalf("RunPreBindPlugins failed: %v", status)
+	}
+	if pl.sawDealine {
+		t.Error("expected no context deadline without WithPreBindPluginTimeout, but one was observed")
+	}
+}
+
 func TestReservePlugins(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -2209,6 +2261,21 @@ func TestListPlugins(t *testing.T) {
 	}
 }
 
+func TestUpdatePluginArgs(t *testing.T) {
+	profile := config.KubeSchedulerProfile{Plugins: &config.Plugins{}}
+	f, err := newFrameworkWithQueueSortAndBind(registry, profile)
+	if err != nil {
+		t.Fatalf("Failed to create framework for testing: %v", err)
+	}
+
+	if err := f.UpdatePluginArgs("does-not-exist", nil); err == nil {
+		t.Error("expected an error updating args for an unconfigured plugin")
+	}
+	if err := f.UpdatePluginArgs(bindPlugin, nil); err == nil {
+		t.Error("expected an error updating args for a plugin that doesn't implement UpdatablePlugin")
+	}
+}
+
 func buildScoreConfigDefaultWeights(ps ...string) *config.Plugins {
 	return buildScoreConfigWithWeights(defaultWeights, ps...)
 }
@@ -2318,3 +2385,92 @@ func collectAndComparePermitWaitDuration(t *testing.T, wantRes string) {
 		}
 	}
 }
+
+func TestRegisterEventHandler(t *testing.T) {
+	client := clientsetfake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	profile := config.KubeSchedulerProfile{Plugins: &config.Plugins{}}
+	f, err := newFrameworkWithQueueSortAndBind(registry, profile, WithInformerFactory(informerFactory))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	podInformer := informerFactory.Core().V1().Pods().Informer()
+	var calls int
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { calls++ },
+	}
+
+	if !f.RegisterEventHandler("plugin-a", podInformer, handler) {
+		t.Error("expected the first registration for a key to succeed")
+	}
+	if f.RegisterEventHandler("plugin-a", podInformer, handler) {
+		t.Error("expected a second registration under the same key to be a no-op")
+	}
+	if !f.RegisterEventHandler("plugin-b", podInformer, handler) {
+		t.Error("expected registration under a different key to succeed")
+	}
+}
+
+// orderTrackingReservePlugin records Reserve/Unreserve invocations so tests
+// can assert on ordering.
+type orderTrackingReservePlugin struct {
+	name         string
+	failReserve  bool
+	reserveLog   *[]string
+	unreserveLog *[]string
+}
+
+func (pl *orderTrackingReservePlugin) Name() string { return pl.name }
+
+func (pl *orderTrackingReservePlugin) Reserve(ctx context.Context, state *framework.CycleState, p *v1.Pod, nodeName string) *framework.Status {
+	if pl.failReserve {
+		return framework.AsStatus(errInjectedStatus)
+	}
+	*pl.reserveLog = append(*pl.reserveLog, pl.name)
+	return nil
+}
+
+func (pl *orderTrackingReservePlugin) Unreserve(ctx context.Context, state *framework.CycleState, p *v1.Pod, nodeName string) {
+	*pl.unreserveLog = append(*pl.unreserveLog, pl.name)
+}
+
+func TestRunReservePluginsUnreserveOnlyUndoesSuccessfulReserves(t *testing.T) {
+	var reserveLog, unreserveLog []string
+	registry := Registry{}
+	configPlugins := &config.Plugins{}
+
+	plugins := []*orderTrackingReservePlugin{
+		{name: "a", reserveLog: &reserveLog, unreserveLog: &unreserveLog},
+		{name: "b", reserveLog: &reserveLog, unreserveLog: &unreserveLog},
+		{name: "c", failReserve: true, reserveLog: &reserveLog, unreserveLog: &unreserveLog},
+	}
+	for _, pl := range plugins {
+		tmpPl := pl
+		if err := registry.Register(pl.name, func(_ runtime.Object, _ framework.Handle) (framework.Plugin, error) {
+			return tmpPl, nil
+		}); err != nil {
+			t.Fatalf("unable to register plugin %s: %v", pl.name, err)
+		}
+		configPlugins.Reserve.Enabled = append(configPlugins.Reserve.Enabled, config.Plugin{Name: pl.name})
+	}
+
+	profile := config.KubeSchedulerProfile{Plugins: configPlugins}
+	f, err := newFrameworkWithQueueSortAndBind(registry, profile)
+	if err != nil {
+		t.Fatalf("fail to create framework: %v", err)
+	}
+
+	state := framework.NewCycleState()
+	if status := f.RunReservePluginsReserve(context.TODO(), state, pod, ""); status.IsSuccess() {
+		t.Fatalf("expected reserve to fail, got success")
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, reserveLog); diff != "" {
+		t.Errorf("unexpected reserve order (-want, +got):\n%s", diff)
+	}
+
+	f.RunReservePluginsUnreserve(context.TODO(), state, pod, "")
+	if diff := cmp.Diff([]string{"b", "a"}, unreserveLog); diff != "" {
+		t.Errorf("unexpected unreserve order (-want, +got):\n%s", diff)
+	}
+}
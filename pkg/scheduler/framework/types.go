@@ -231,6 +231,16 @@ const (
 func (f *FitError) Error() string {
 	reasons := make(map[string]int)
 	for _, status := range f.Diagnosis.NodeToStatusMap {
+		// Plugins that attach structured diagnostics to their Status take
+		// precedence over their free-text reasons, since the diagnostics
+		// identify the same root cause across nodes even when a plugin's
+		// prose reason embeds per-node details (e.g. a claim name).
+		if diagnostics := status.Diagnostics(); len(diagnostics) > 0 {
+			for _, d := range diagnostics {
+				reasons[fmt.Sprintf("%s: %s", d.Key, d.Value)]++
+			}
+			continue
+		}
 		for _, reason := range status.Reasons() {
 			reasons[reason]++
 		}
@@ -391,6 +401,14 @@ type NodeInfo struct {
 	// Keys are in the format "namespace/name".
 	PVCRefCounts map[string]int
 
+	// AssumedPVCapacity is the total storage capacity, in bytes, of
+	// PersistentVolumes that the VolumeBinding plugin has tentatively bound
+	// to pods assigned to this node during the current scheduling cycle but
+	// that the PV controller has not yet finished binding. It lets resource
+	// scoring plugins account for local storage pressure that isn't yet
+	// reflected in the node's actual allocated volumes.
+	AssumedPVCapacity int64
+
 	// Whenever NodeInfo changes, generation is bumped.
 	// This is used to avoid cloning it if the object didn't change.
 	Generation int64
@@ -535,14 +553,15 @@ func (n *NodeInfo) Node() *v1.Node {
 // Clone returns a copy of this node.
 func (n *NodeInfo) Clone() *NodeInfo {
 	clone := &NodeInfo{
-		node:             n.node,
-		Requested:        n.Requested.Clone(),
-		NonZeroRequested: n.NonZeroRequested.Clone(),
-		Allocatable:      n.Allocatable.Clone(),
-		UsedPorts:        make(HostPortInfo),
-		ImageStates:      n.ImageStates,
-		PVCRefCounts:     n.PVCRefCounts,
-		Generation:       n.Generation,
+		node:              n.node,
+		Requested:         n.Requested.Clone(),
+		NonZeroRequested:  n.NonZeroRequested.Clone(),
+		Allocatable:       n.Allocatable.Clone(),
+		UsedPorts:         make(HostPortInfo),
+		ImageStates:       n.ImageStates,
+		PVCRefCounts:      n.PVCRefCounts,
+		AssumedPVCapacity: n.AssumedPVCapacity,
+		Generation:        n.Generation,
 	}
 	if len(n.Pods) > 0 {
 		clone.Pods = append([]*PodInfo(nil), n.Pods...)
@@ -776,6 +795,24 @@ func (n *NodeInfo) updatePVCRefCounts(pod *v1.Pod, add bool) {
 	}
 }
 
+// AddAssumedPVCapacity adds bytes to the node's AssumedPVCapacity and bumps
+// the generation so cloned snapshots pick up the change.
+func (n *NodeInfo) AddAssumedPVCapacity(bytes int64) {
+	n.AssumedPVCapacity += bytes
+	n.Generation = nextGeneration()
+}
+
+// RemoveAssumedPVCapacity subtracts bytes from the node's AssumedPVCapacity,
+// e.g. when an assumed volume binding is reverted. It never drives the
+// total below zero.
+func (n *NodeInfo) RemoveAssumedPVCapacity(bytes int64) {
+	n.AssumedPVCapacity -= bytes
+	if n.AssumedPVCapacity < 0 {
+		n.AssumedPVCapacity = 0
+	}
+	n.Generation = nextGeneration()
+}
+
 // SetNode sets the overall node information.
 func (n *NodeInfo) SetNode(node *v1.Node) {
 	n.node = node
@@ -1373,3 +1373,79 @@ func TestGetNamespacesFromPodAffinityTerm(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeInfoAssumedPVCapacity(t *testing.T) {
+	ni := NewNodeInfo()
+
+	ni.AddAssumedPVCapacity(100)
+	ni.AddAssumedPVCapacity(50)
+	if ni.AssumedPVCapacity != 150 {
+		t.Errorf("expected AssumedPVCapacity 150, got %d", ni.AssumedPVCapacity)
+	}
+
+	ni.RemoveAssumedPVCapacity(60)
+	if ni.AssumedPVCapacity != 90 {
+		t.Errorf("expected AssumedPVCapacity 90, got %d", ni.AssumedPVCapacity)
+	}
+
+	// Removing more than what's tracked should floor at zero rather than go negative.
+	ni.RemoveAssumedPVCapacity(1000)
+	if ni.AssumedPVCapacity != 0 {
+		t.Errorf("expected AssumedPVCapacity 0, got %d", ni.AssumedPVCapacity)
+	}
+
+	clone := ni.Clone()
+	ni.AddAssumedPVCapacity(10)
+	if clone.AssumedPVCapacity != 0 {
+		t.Errorf("expected clone to be unaffected by later mutation, got %d", clone.AssumedPVCapacity)
+	}
+}
+
+func TestFitError_Error(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodeToStatus   NodeToStatusMap
+		wantSubstrings []string
+	}{
+		{
+			name: "falls back to free-text reasons when no diagnostics are set",
+			nodeToStatus: NodeToStatusMap{
+				"node-a": NewStatus(Unschedulable, "Insufficient cpu"),
+				"node-b": NewStatus(Unschedulable, "Insufficient cpu"),
+			},
+			wantSubstrings: []string{"2 Insufficient cpu"},
+		},
+		{
+			name: "prefers structured diagnostics over reasons when present",
+			nodeToStatus: NodeToStatusMap{
+				"node-a": func() *Status {
+					s := NewStatus(Unschedulable, "node(s) had volume node affinity conflict")
+					s.AddDiagnostic("persistentvolumeclaim/default/data-0", "node(s) had volume node affinity conflict")
+					return s
+				}(),
+				"node-b": func() *Status {
+					s := NewStatus(Unschedulable, "node(s) had volume node affinity conflict")
+					s.AddDiagnostic("persistentvolumeclaim/default/data-0", "node(s) had volume node affinity conflict")
+					return s
+				}(),
+			},
+			wantSubstrings: []string{"2 persistentvolumeclaim/default/data-0: node(s) had volume node affinity conflict"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fitErr := &FitError{
+				Pod:         &v1.Pod{},
+				NumAllNodes: len(tt.nodeToStatus),
+				Diagnosis:   Diagnosis{NodeToStatusMap: tt.nodeToStatus},
+			}
+			msg := fitErr.Error()
+			for _, want := range tt.wantSubstrings {
+				if !strings.Contains(msg, want) {
+					t.Errorf("Error() = %q, want substring %q", msg, want)
+				}
+			}
+		})
+	}
+}
@@ -164,6 +164,25 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		}, []string{"type"})
 
+	BindingQueueWaitDuration = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Subsystem:      SchedulerSubsystem,
+			Name:           "binding_queue_wait_duration_seconds",
+			Help:           "Duration a pod's binding cycle spent waiting for a free slot in the bounded binding worker pool, in seconds. Only observed when a pool size is configured.",
+			Buckets:        metrics.ExponentialBuckets(0.001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	PreBindVolumeWaitDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      SchedulerSubsystem,
+			Name:           "prebind_volume_wait_duration_seconds",
+			Help:           "Duration a pod's PreBind phase spent waiting for its volumes to finish binding, in seconds.",
+			Buckets:        metrics.ExponentialBuckets(0.001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"result"})
+
 	metricsList = []metrics.Registerable{
 		scheduleAttempts,
 		e2eSchedulingLatency,
@@ -179,6 +198,8 @@ var (
 		SchedulerGoroutines,
 		PermitWaitDuration,
 		CacheSize,
+		PreBindVolumeWaitDuration,
+		BindingQueueWaitDuration,
 	}
 )
 
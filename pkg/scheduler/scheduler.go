@@ -91,6 +91,16 @@ type Scheduler struct {
 	// SchedulingQueue holds pods to be scheduled
 	SchedulingQueue internalqueue.SchedulingQueue
 
+	// bindTimeoutSeconds bounds how long the binding cycle (WaitOnPermit, PreBind,
+	// Bind and PostBind) may run for a single pod. A value of 0 means no timeout.
+	bindTimeoutSeconds int64
+
+	// bindingWorkerPool bounds how many binding cycles may run concurrently.
+	// A nil channel leaves binding concurrency unbounded. Entries are held
+	// for the lifetime of a pod's binding cycle, so the pool size caps the
+	// number of pods simultaneously blocked in WaitOnPermit/PreBind/Bind.
+	bindingWorkerPool chan struct{}
+
 	// Profiles are the scheduling profiles.
 	Profiles profile.Map
 
@@ -98,12 +108,15 @@ type Scheduler struct {
 }
 
 type schedulerOptions struct {
-	componentConfigVersion   string
-	kubeConfig               *restclient.Config
-	legacyPolicySource       *schedulerapi.SchedulerPolicySource
-	percentageOfNodesToScore int32
-	podInitialBackoffSeconds int64
-	podMaxBackoffSeconds     int64
+	componentConfigVersion      string
+	kubeConfig                  *restclient.Config
+	legacyPolicySource          *schedulerapi.SchedulerPolicySource
+	percentageOfNodesToScore    int32
+	podInitialBackoffSeconds    int64
+	podMaxBackoffSeconds        int64
+	bindTimeoutSeconds          int64
+	preBindPluginTimeoutSeconds int64
+	bindingWorkerPoolSize       int32
 	// Contains out-of-tree plugins to be merged with the in-tree registry.
 	frameworkOutOfTreeRegistry frameworkruntime.Registry
 	profiles                   []schedulerapi.KubeSchedulerProfile
@@ -185,6 +198,32 @@ func WithPodMaxBackoffSeconds(podMaxBackoffSeconds int64) Option {
 	}
 }
 
+// WithBindTimeoutSeconds sets bindTimeoutSeconds for Scheduler, the default value is 0 (no timeout)
+func WithBindTimeoutSeconds(bindTimeoutSeconds int64) Option {
+	return func(o *schedulerOptions) {
+		o.bindTimeoutSeconds = bindTimeoutSeconds
+	}
+}
+
+// WithPreBindPluginTimeoutSeconds sets preBindPluginTimeoutSeconds for Scheduler,
+// bounding how long a single PreBind plugin call may run. The default value is 0
+// (no per-plugin deadline).
+func WithPreBindPluginTimeoutSeconds(preBindPluginTimeoutSeconds int64) Option {
+	return func(o *schedulerOptions) {
+		o.preBindPluginTimeoutSeconds = preBindPluginTimeoutSeconds
+	}
+}
+
+// WithBindingWorkerPoolSize bounds how many binding cycles (WaitOnPermit
+// through PostBind) may run concurrently, so a burst of pending pods can't
+// spawn an unbounded number of goroutines blocked on bind-wait. The default
+// value is 0, which leaves binding concurrency unbounded.
+func WithBindingWorkerPoolSize(bindingWorkerPoolSize int32) Option {
+	return func(o *schedulerOptions) {
+		o.bindingWorkerPoolSize = bindingWorkerPoolSize
+	}
+}
+
 // WithExtenders sets extenders for the Scheduler
 func WithExtenders(e ...schedulerapi.Extender) Option {
 	return func(o *schedulerOptions) {
@@ -251,23 +290,24 @@ func New(client clientset.Interface,
 	clusterEventMap := make(map[framework.ClusterEvent]sets.String)
 
 	configurator := &Configurator{
-		componentConfigVersion:   options.componentConfigVersion,
-		client:                   client,
-		kubeConfig:               options.kubeConfig,
-		recorderFactory:          recorderFactory,
-		informerFactory:          informerFactory,
-		schedulerCache:           schedulerCache,
-		StopEverything:           stopEverything,
-		percentageOfNodesToScore: options.percentageOfNodesToScore,
-		podInitialBackoffSeconds: options.podInitialBackoffSeconds,
-		podMaxBackoffSeconds:     options.podMaxBackoffSeconds,
-		profiles:                 append([]schedulerapi.KubeSchedulerProfile(nil), options.profiles...),
-		registry:                 registry,
-		nodeInfoSnapshot:         snapshot,
-		extenders:                options.extenders,
-		frameworkCapturer:        options.frameworkCapturer,
-		parallellism:             options.parallelism,
-		clusterEventMap:          clusterEventMap,
+		componentConfigVersion:      options.componentConfigVersion,
+		client:                      client,
+		kubeConfig:                  options.kubeConfig,
+		recorderFactory:             recorderFactory,
+		informerFactory:             informerFactory,
+		schedulerCache:              schedulerCache,
+		StopEverything:              stopEverything,
+		percentageOfNodesToScore:    options.percentageOfNodesToScore,
+		podInitialBackoffSeconds:    options.podInitialBackoffSeconds,
+		podMaxBackoffSeconds:        options.podMaxBackoffSeconds,
+		profiles:                    append([]schedulerapi.KubeSchedulerProfile(nil), options.profiles...),
+		registry:                    registry,
+		nodeInfoSnapshot:            snapshot,
+		extenders:                   options.extenders,
+		frameworkCapturer:           options.frameworkCapturer,
+		parallellism:                options.parallelism,
+		clusterEventMap:             clusterEventMap,
+		preBindPluginTimeoutSeconds: options.preBindPluginTimeoutSeconds,
 	}
 
 	metrics.Register()
@@ -307,6 +347,10 @@ func New(client clientset.Interface,
 	// Additional tweaks to the config produced by the configurator.
 	sched.StopEverything = stopEverything
 	sched.client = client
+	sched.bindTimeoutSeconds = options.bindTimeoutSeconds
+	if options.bindingWorkerPoolSize > 0 {
+		sched.bindingWorkerPool = make(chan struct{}, options.bindingWorkerPoolSize)
+	}
 
 	// Build dynamic client and dynamic informer factory
 	var dynInformerFactory dynamicinformer.DynamicSharedInformerFactory
@@ -610,11 +654,34 @@ func (sched *Scheduler) scheduleOne(ctx context.Context) {
 
 	// bind the pod to its host asynchronously (we can do this b/c of the assumption step above).
 	go func() {
-		bindingCycleCtx, cancel := context.WithCancel(ctx)
+		var bindingCycleCtx context.Context
+		var cancel context.CancelFunc
+		if sched.bindTimeoutSeconds > 0 {
+			bindingCycleCtx, cancel = context.WithTimeout(ctx, time.Duration(sched.bindTimeoutSeconds)*time.Second)
+		} else {
+			bindingCycleCtx, cancel = context.WithCancel(ctx)
+		}
 		defer cancel()
 		metrics.SchedulerGoroutines.WithLabelValues(metrics.Binding).Inc()
 		defer metrics.SchedulerGoroutines.WithLabelValues(metrics.Binding).Dec()
 
+		if sched.bindingWorkerPool != nil {
+			waitStart := time.Now()
+			select {
+			case sched.bindingWorkerPool <- struct{}{}:
+				metrics.BindingQueueWaitDuration.Observe(metrics.SinceInSeconds(waitStart))
+			case <-bindingCycleCtx.Done():
+				metrics.PodScheduleError(fwk.ProfileName(), metrics.SinceInSeconds(start))
+				fwk.RunReservePluginsUnreserve(bindingCycleCtx, state, assumedPod, scheduleResult.SuggestedHost)
+				if forgetErr := sched.SchedulerCache.ForgetPod(assumedPod); forgetErr != nil {
+					klog.ErrorS(forgetErr, "scheduler cache ForgetPod failed")
+				}
+				sched.recordSchedulingFailure(fwk, assumedPodInfo, fmt.Errorf("waiting for a free binding worker: %w", bindingCycleCtx.Err()), SchedulerError, "")
+				return
+			}
+			defer func() { <-sched.bindingWorkerPool }()
+		}
+
 		waitOnPermitStatus := fwk.WaitOnPermit(bindingCycleCtx, assumedPod)
 		if !waitOnPermitStatus.IsSuccess() {
 			var reason string
@@ -308,17 +308,19 @@ func TestSchedulerScheduleOne(t *testing.T) {
 	preBindErr := errors.New("on PreBind")
 
 	table := []struct {
-		name                string
-		injectBindError     error
-		sendPod             *v1.Pod
-		algo                core.ScheduleAlgorithm
-		registerPluginFuncs []st.RegisterPluginFunc
-		expectErrorPod      *v1.Pod
-		expectForgetPod     *v1.Pod
-		expectAssumedPod    *v1.Pod
-		expectError         error
-		expectBind          *v1.Binding
-		eventReason         string
+		name                  string
+		injectBindError       error
+		sendPod               *v1.Pod
+		algo                  core.ScheduleAlgorithm
+		registerPluginFuncs   []st.RegisterPluginFunc
+		expectErrorPod        *v1.Pod
+		expectForgetPod       *v1.Pod
+		expectAssumedPod      *v1.Pod
+		expectError           error
+		expectBind            *v1.Binding
+		eventReason           string
+		bindingWorkerPoolSize int32
+		bindTimeoutSeconds    int64
 	}{
 		{
 			name:    "error reserve pod",
@@ -393,6 +395,18 @@ func TestSchedulerScheduleOne(t *testing.T) {
 			algo:        mockScheduler{core.ScheduleResult{}, nil},
 			eventReason: "FailedScheduling",
 		},
+		{
+			name:                  "binding worker pool saturated",
+			sendPod:               podWithID("foo", ""),
+			algo:                  mockScheduler{core.ScheduleResult{SuggestedHost: testNode.Name, EvaluatedNodes: 1, FeasibleNodes: 1}, nil},
+			expectErrorPod:        podWithID("foo", testNode.Name),
+			expectForgetPod:       podWithID("foo", testNode.Name),
+			expectAssumedPod:      podWithID("foo", testNode.Name),
+			expectError:           fmt.Errorf("waiting for a free binding worker: %w", context.DeadlineExceeded),
+			eventReason:           "FailedScheduling",
+			bindingWorkerPoolSize: 1,
+			bindTimeoutSeconds:    1,
+		},
 	}
 
 	stop := make(chan struct{})
@@ -457,6 +471,14 @@ func TestSchedulerScheduleOne(t *testing.T) {
 				Profiles: profile.Map{
 					testSchedulerName: fwk,
 				},
+				bindTimeoutSeconds: item.bindTimeoutSeconds,
+			}
+			if item.bindingWorkerPoolSize > 0 {
+				s.bindingWorkerPool = make(chan struct{}, item.bindingWorkerPoolSize)
+				// Fill the pool so the binding cycle has to wait for a slot.
+				for i := int32(0); i < item.bindingWorkerPoolSize; i++ {
+					s.bindingWorkerPool <- struct{}{}
+				}
 			}
 			called := make(chan struct{})
 			stopFunc := eventBroadcaster.StartEventWatcher(func(obj runtime.Object) {
@@ -982,6 +1004,63 @@ func setupTestSchedulerWithVolumeBinding(volumeBinder scheduling.SchedulerVolume
 	return s, bindingChan, errChan
 }
 
+func TestSchedulerSimulatePod(t *testing.T) {
+	testNode := v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "machine1", UID: types.UID("machine1")}}
+	pod := podWithID("foo", "")
+	pod.Namespace = "foo-ns"
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{Name: "testVol",
+		VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "testPVC"}}})
+	testPVC := v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "testPVC", Namespace: pod.Namespace, UID: types.UID("testPVC")}}
+	client := clientsetfake.NewSimpleClientset(&testNode, &testPVC)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	pvcInformer := informerFactory.Core().V1().PersistentVolumeClaims()
+	pvcInformer.Informer().GetStore().Add(&testPVC)
+
+	fakeVolumeBinder := scheduling.NewFakeVolumeBinder(&scheduling.FakeVolumeBinderConfig{AllBound: false})
+
+	fns := []st.RegisterPluginFunc{
+		st.RegisterQueueSortPlugin(queuesort.Name, queuesort.New),
+		st.RegisterBindPlugin(defaultbinder.Name, defaultbinder.New),
+		st.RegisterPluginAsExtensions(volumebinding.Name, func(plArgs runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+			return &volumebinding.VolumeBinding{Binder: fakeVolumeBinder, PVCLister: pvcInformer.Lister()}, nil
+		}, "PreFilter", "Filter", "Reserve", "PreBind"),
+	}
+	snapshot := internalcache.NewSnapshot(nil, []*v1.Node{&testNode})
+	fwk, err := st.NewFramework(
+		fns,
+		testSchedulerName,
+		frameworkruntime.WithClientSet(client),
+		frameworkruntime.WithInformerFactory(informerFactory),
+		frameworkruntime.WithSnapshotSharedLister(snapshot),
+		frameworkruntime.WithPodNominator(internalqueue.NewPodNominator(informerFactory.Core().V1().Pods().Lister())),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create framework: %v", err)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	informerFactory.Start(stop)
+	informerFactory.WaitForCacheSync(stop)
+
+	sched := &Scheduler{
+		Profiles: profile.Map{testSchedulerName: fwk},
+	}
+
+	result, err := sched.SimulatePod(context.Background(), testSchedulerName, pod)
+	if err != nil {
+		t.Fatalf("SimulatePod failed: %v", err)
+	}
+	if len(result.FeasibleNodes) != 1 || result.FeasibleNodes[0] != testNode.Name {
+		t.Errorf("FeasibleNodes = %v, want [%s]", result.FeasibleNodes, testNode.Name)
+	}
+	if _, ok := result.VolumeBindings[testNode.Name]; !ok {
+		t.Errorf("expected a volume binding plan for node %s", testNode.Name)
+	}
+	if fakeVolumeBinder.AssumeCalled {
+		t.Errorf("SimulatePod must not assume volumes, but AssumeCalled is true")
+	}
+}
+
 // This is a workaround because golint complains that errors cannot
 // end with punctuation.  However, the real predicate error message does
 // end with a period.
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/controller/volume/scheduling"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/volumebinding"
+)
+
+// SimulationResult is the outcome of SimulatePod.
+type SimulationResult struct {
+	// FeasibleNodes are the nodes pod could run on, in the order the
+	// scheduler's node snapshot returned them.
+	FeasibleNodes []string
+	// VolumeBindings maps a feasible node to the volume binding plan
+	// VolumeBinding's Filter phase computed for it. It has no entry for a
+	// node if the pod has no PVCs, or if the profile has no VolumeBinding
+	// plugin configured.
+	VolumeBindings map[string]*scheduling.PodVolumes
+}
+
+// SimulatePod runs PreFilter and Filter for pod, under profileName, against
+// every node in the scheduler's current snapshot, and reports which nodes it
+// would fit on and, for nodes where a volume binding plan was computed,
+// which PVs/PVCs it implies. Unlike a real scheduling cycle, it never
+// Reserves or PreBinds anything: it doesn't call
+// SchedulerVolumeBinder.AssumePodVolumes and it never talks to the API
+// server, so it's safe to call repeatedly from a capacity-planning tool that
+// wants to ask "where would this pod and its PVCs land?" without affecting
+// real scheduling decisions.
+func (sched *Scheduler) SimulatePod(ctx context.Context, profileName string, pod *v1.Pod) (*SimulationResult, error) {
+	fwk, ok := sched.Profiles[profileName]
+	if !ok {
+		return nil, fmt.Errorf("profile %q does not exist", profileName)
+	}
+
+	state := framework.NewCycleState()
+	if status := fwk.RunPreFilterPlugins(ctx, state, pod); !status.IsSuccess() {
+		if status.IsUnschedulable() {
+			return &SimulationResult{}, nil
+		}
+		return nil, status.AsError()
+	}
+
+	nodeInfos, err := fwk.SnapshotSharedLister().NodeInfos().List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SimulationResult{VolumeBindings: make(map[string]*scheduling.PodVolumes)}
+	for _, nodeInfo := range nodeInfos {
+		if status := fwk.RunFilterPluginsWithNominatedPods(ctx, state, pod, nodeInfo); !status.IsSuccess() {
+			continue
+		}
+		nodeName := nodeInfo.Node().Name
+		result.FeasibleNodes = append(result.FeasibleNodes, nodeName)
+	}
+
+	if pl, ok := fwk.Plugin(names.VolumeBinding); ok {
+		vb := pl.(*volumebinding.VolumeBinding)
+		for _, nodeName := range result.FeasibleNodes {
+			if podVolumes, ok := vb.SimulatedPodVolumes(state, nodeName); ok {
+				result.VolumeBindings[nodeName] = podVolumes
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
 )
 
@@ -323,6 +324,15 @@ func (c *csiAttacher) MountDevice(spec *volume.Spec, devicePath string, deviceMo
 		return errors.New(log("attacher.MountDevice failed to create dir %#v:  %v", deviceMountPath, err))
 	}
 	klog.V(4).Info(log("created target path successfully [%s]", deviceMountPath))
+
+	// deviceMountPath is kubelet-owned, but a compromised driver from a
+	// previous NodeStage could have replaced one of its ancestors with a
+	// symlink that walks outside the plugin's directory; refuse to hand
+	// such a path to the driver.
+	if err := util.ValidatePathNoSymlinkEscape(c.plugin.host, deviceMountPath, c.plugin.host.GetPluginDir(c.plugin.GetPluginName())); err != nil {
+		return errors.New(log("attacher.MountDevice failed to validate device mount path %#v:  %v", deviceMountPath, err))
+	}
+
 	dataDir := filepath.Dir(deviceMountPath)
 	data := map[string]string{
 		volDataKey.volHandle:  csiSource.VolumeHandle,
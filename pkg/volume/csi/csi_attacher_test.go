@@ -1212,7 +1212,7 @@ func TestAttacherMountDevice(t *testing.T) {
 			csiAttacher.csiClient = setupClient(t, tc.stageUnstageSet)
 
 			if tc.deviceMountPath != "" {
-				tc.deviceMountPath = filepath.Join(tmpDir, tc.deviceMountPath)
+				tc.deviceMountPath = filepath.Join(plug.host.GetPluginDir(plug.GetPluginName()), tc.deviceMountPath)
 			}
 
 			nodeName := string(csiAttacher.plugin.host.GetNodeName())
@@ -1319,6 +1319,43 @@ func TestAttacherMountDevice(t *testing.T) {
 	}
 }
 
+func TestAttacherMountDeviceRejectsPathOutsidePluginDir(t *testing.T) {
+	fakeClient := fakeclient.NewSimpleClientset()
+	plug, tmpDir := newTestPlugin(t, fakeClient)
+	defer os.RemoveAll(tmpDir)
+
+	attacher, err := plug.NewAttacher()
+	if err != nil {
+		t.Fatalf("failed to create new attacher: %v", err)
+	}
+	csiAttacher := getCsiAttacherFromVolumeAttacher(attacher, 0)
+	csiAttacher.csiClient = setupClient(t, true)
+
+	pvName := "test-pv"
+	spec := volume.NewSpecFromPersistentVolume(makeTestPV(pvName, 10, testDriver, "test-vol1"), false)
+
+	// MountDevice looks up the VolumeAttachment before it checks the path, so
+	// create it to make the path check the step that rejects the call.
+	nodeName := string(csiAttacher.plugin.host.GetNodeName())
+	attachID := getAttachmentName("test-vol1", testDriver, nodeName)
+	if _, err := csiAttacher.k8s.StorageV1().VolumeAttachments().Create(context.TODO(), makeTestAttachment(attachID, nodeName, pvName), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to attach: %v", err)
+	}
+
+	// deviceMountPath sits outside plug.host.GetPluginDir(), as if an
+	// ancestor directory had been swapped for a symlink walking outside the
+	// plugin's directory.
+	deviceMountPath := filepath.Join(tmpDir, "escaped", "globalmount")
+	if err := os.MkdirAll(filepath.Dir(deviceMountPath), 0750); err != nil {
+		t.Fatalf("failed to set up test directory: %v", err)
+	}
+
+	err = csiAttacher.MountDevice(spec, "path1", deviceMountPath)
+	if err == nil {
+		t.Fatal("expected MountDevice to reject a deviceMountPath outside the plugin directory, got no error")
+	}
+}
+
 func TestAttacherMountDeviceWithInline(t *testing.T) {
 	defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.CSIInlineVolume, true)()
 	pvName := "test-pv"
@@ -1413,7 +1450,7 @@ func TestAttacherMountDeviceWithInline(t *testing.T) {
 			csiAttacher.csiClient = setupClient(t, tc.stageUnstageSet)
 
 			if tc.deviceMountPath != "" {
-				tc.deviceMountPath = filepath.Join(tmpDir, tc.deviceMountPath)
+				tc.deviceMountPath = filepath.Join(plug.host.GetPluginDir(plug.GetPluginName()), tc.deviceMountPath)
 			}
 
 			nodeName := string(csiAttacher.plugin.host.GetNodeName())
@@ -41,7 +41,7 @@ import (
 	utilstrings "k8s.io/utils/strings"
 )
 
-//TODO (vladimirvivien) move this in a central loc later
+// TODO (vladimirvivien) move this in a central loc later
 var (
 	volDataKey = struct {
 		specVolID,
@@ -209,6 +209,14 @@ func (c *csiMountMgr) SetUpAt(dir string, mounterArgs volume.MounterArgs) error
 	}
 	klog.V(4).Info(log("created target path successfully [%s]", parentDir))
 
+	// parentDir is kubelet-owned, but a compromised driver from a previous
+	// NodePublish could have replaced one of its ancestors with a symlink
+	// that walks outside the pods directory; refuse to hand such a path to
+	// the driver.
+	if err := util.ValidatePathNoSymlinkEscape(c.plugin.host, parentDir, c.plugin.host.GetPodsDir()); err != nil {
+		return errors.New(log("mounter.SetUpAt failed to validate target path %#v:  %v", parentDir, err))
+	}
+
 	nodePublishSecrets = map[string]string{}
 	if secretRef != nil {
 		nodePublishSecrets, err = getCredentialsFromSecret(c.k8s, secretRef)
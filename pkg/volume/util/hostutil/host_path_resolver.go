@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxSymlinksWalked bounds symlink resolution, mirroring the limit enforced
+// by filepath.EvalSymlinks.
+const maxSymlinksWalked = 255
+
+// HostPathResolver translates a path as kubelet sees it into the path that
+// actually has to be opened to reach the same file on the host. All volume
+// plugins resolve host-visible paths through HostUtil.EvalHostSymlinks, so
+// a single implementation here covers every plugin instead of each one
+// special-casing a containerized kubelet's root remapping itself.
+type HostPathResolver interface {
+	// ResolvePath returns the path, with symlinks evaluated, that kubelet
+	// must use to reach pathname.
+	ResolvePath(pathname string) (string, error)
+}
+
+// defaultHostPathResolver is used when kubelet sees the same filesystem as
+// the host: no remapping is necessary.
+type defaultHostPathResolver struct{}
+
+func (defaultHostPathResolver) ResolvePath(pathname string) (string, error) {
+	return filepath.EvalSymlinks(pathname)
+}
+
+// rootedHostPathResolver is used when kubelet only sees the host filesystem
+// mounted under root, e.g. a containerized kubelet with the host's "/"
+// bind-mounted at "/rootfs".
+type rootedHostPathResolver struct {
+	root string
+}
+
+// NewRootedHostPathResolver returns a HostPathResolver for a kubelet that
+// only sees the host filesystem mounted under root.
+func NewRootedHostPathResolver(root string) HostPathResolver {
+	return rootedHostPathResolver{root: root}
+}
+
+func (r rootedHostPathResolver) ResolvePath(pathname string) (string, error) {
+	return EvalSymlinksAtNewRoot(r.root, pathname)
+}
+
+// EvalSymlinksAtNewRoot behaves like filepath.EvalSymlinks(pathname), except
+// it resolves pathname as if root were "/": every symlink it follows,
+// absolute or relative, is confined underneath root and can never walk
+// above it. It returns the resolved path joined back onto root, i.e. a path
+// that can be opened directly by a process that only sees root as its view
+// of the host filesystem.
+//
+// As with filepath.EvalSymlinks, every path component other than the last
+// must exist.
+func EvalSymlinksAtNewRoot(root, pathname string) (string, error) {
+	if !filepath.IsAbs(pathname) {
+		return "", fmt.Errorf("pathname %q must be absolute", pathname)
+	}
+
+	root = filepath.Clean(root)
+	components := splitAbs(filepath.Clean(pathname))
+	var resolved []string
+	linksWalked := 0
+
+	for len(components) > 0 {
+		component := components[0]
+		components = components[1:]
+
+		switch component {
+		case ".":
+			continue
+		case "..":
+			if len(resolved) > 0 {
+				resolved = resolved[:len(resolved)-1]
+			}
+			continue
+		}
+
+		candidate := append(append([]string{}, resolved...), component)
+		hostPath := filepath.Join(root, filepath.Join(candidate...))
+
+		fi, err := os.Lstat(hostPath)
+		if err != nil {
+			if os.IsNotExist(err) && len(components) == 0 {
+				// Mirror filepath.EvalSymlinks: the final component does
+				// not need to exist.
+				resolved = candidate
+				break
+			}
+			return "", err
+		}
+
+		if fi.Mode()&os.ModeSymlink == 0 {
+			resolved = candidate
+			continue
+		}
+
+		linksWalked++
+		if linksWalked > maxSymlinksWalked {
+			return "", fmt.Errorf("too many levels of symbolic links resolving %q under root %q", pathname, root)
+		}
+		target, err := os.Readlink(hostPath)
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			resolved = nil
+		}
+		// A relative target is resolved against the symlink's own
+		// directory, i.e. the not-yet-committed `resolved`.
+		components = append(splitAbs(filepath.Clean(target)), components...)
+	}
+
+	return filepath.Join(root, filepath.Join(resolved...)), nil
+}
+
+// splitAbs splits a cleaned path into its non-empty components.
+func splitAbs(p string) []string {
+	p = strings.TrimPrefix(p, string(filepath.Separator))
+	if p == "" || p == "." {
+		return nil
+	}
+	return strings.Split(p, string(filepath.Separator))
+}
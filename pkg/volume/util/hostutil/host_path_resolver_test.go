@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEvalSymlinksAtNewRoot(t *testing.T) {
+	root, err := os.MkdirTemp("", "hostutil-eval-symlinks")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	mustMkdir := func(rel string) {
+		if err := os.MkdirAll(filepath.Join(root, rel), 0755); err != nil {
+			t.Fatalf("cannot create %q: %v", rel, err)
+		}
+	}
+	mustSymlink := func(target, rel string) {
+		if err := os.Symlink(target, filepath.Join(root, rel)); err != nil {
+			t.Fatalf("cannot symlink %q -> %q: %v", rel, target, err)
+		}
+	}
+
+	mustMkdir("real/data")
+	mustMkdir("nested")
+	mustSymlink("/real", "abs-link")
+	mustSymlink("../real/data", "nested/rel-link")
+
+	tests := []struct {
+		name     string
+		pathname string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no symlinks",
+			pathname: "/real/data",
+			expected: filepath.Join(root, "real/data"),
+		},
+		{
+			name:     "absolute symlink component",
+			pathname: "/abs-link/data",
+			expected: filepath.Join(root, "real/data"),
+		},
+		{
+			name:     "relative symlink component",
+			pathname: "/nested/rel-link",
+			expected: filepath.Join(root, "real/data"),
+		},
+		{
+			name:     "final component need not exist",
+			pathname: "/real/data/does-not-exist",
+			expected: filepath.Join(root, "real/data/does-not-exist"),
+		},
+		{
+			name:     "intermediate component does not exist",
+			pathname: "/does-not-exist/data",
+			wantErr:  true,
+		},
+		{
+			name:     "relative pathname rejected",
+			pathname: "real/data",
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := EvalSymlinksAtNewRoot(root, test.pathname)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got result %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.expected {
+				t.Errorf("got %q, want %q", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestRootedHostPathResolverEscapeAttempt(t *testing.T) {
+	root, err := os.MkdirTemp("", "hostutil-eval-symlinks-escape")
+	if err != nil {
+		t.Fatalf("cannot create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	if err := os.MkdirAll(filepath.Join(root, "etc"), 0755); err != nil {
+		t.Fatalf("cannot create etc dir: %v", err)
+	}
+
+	resolver := NewRootedHostPathResolver(root)
+	// ".." components can never walk above root, even when there are more of
+	// them than the path is deep.
+	got, err := resolver.ResolvePath("/../../../etc/passwd")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := filepath.Join(root, "etc/passwd")
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
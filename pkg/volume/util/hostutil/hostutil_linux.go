@@ -39,12 +39,21 @@ const (
 
 // HostUtil implements HostUtils for Linux platforms.
 type HostUtil struct {
+	pathResolver HostPathResolver
 }
 
 // NewHostUtil returns a struct that implements the HostUtils interface on
 // linux platforms
 func NewHostUtil() *HostUtil {
-	return &HostUtil{}
+	return &HostUtil{pathResolver: defaultHostPathResolver{}}
+}
+
+// NewContainerizedHostUtil returns a struct that implements the HostUtils
+// interface, with all host paths resolved through root. Use this when
+// kubelet itself runs in a container and only sees the real host filesystem
+// mounted at root (commonly "/rootfs").
+func NewContainerizedHostUtil(root string) *HostUtil {
+	return &HostUtil{pathResolver: NewRootedHostPathResolver(root)}
 }
 
 // DeviceOpened checks if block device in use by calling Open with O_EXCL flag.
@@ -155,7 +164,7 @@ func (hu *HostUtil) PathExists(pathname string) (bool, error) {
 // TODO once the nsenter implementation is removed, this method can be removed
 // from the interface and filepath.EvalSymlinks used directly
 func (hu *HostUtil) EvalHostSymlinks(pathname string) (string, error) {
-	return filepath.EvalSymlinks(pathname)
+	return hu.pathResolver.ResolvePath(pathname)
 }
 
 // FindMountInfo returns the mount info on the given path.
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"os"
 
+	"k8s.io/klog/v2"
 	"k8s.io/mount-utils"
 )
 
@@ -35,6 +36,13 @@ func NewHostUtil() *HostUtil {
 	return &HostUtil{}
 }
 
+// NewContainerizedHostUtil is not supported on this platform and returns the
+// same HostUtil as NewHostUtil, ignoring root.
+func NewContainerizedHostUtil(root string) *HostUtil {
+	klog.Warningf("containerized host path resolution is not supported on this platform, ignoring root %q", root)
+	return NewHostUtil()
+}
+
 var errUnsupported = errors.New("volume/util/hostutil on this platform is not supported")
 
 // DeviceOpened always returns an error on unsupported platforms
@@ -38,6 +38,13 @@ func NewHostUtil() *HostUtil {
 	return &HostUtil{}
 }
 
+// NewContainerizedHostUtil is not supported on Windows and returns the same
+// HostUtil as NewHostUtil, ignoring root.
+func NewContainerizedHostUtil(root string) *HostUtil {
+	klog.Warningf("containerized host path resolution is not supported on Windows, ignoring root %q", root)
+	return NewHostUtil()
+}
+
 // GetDeviceNameFromMount given a mnt point, find the device
 func (hu *HostUtil) GetDeviceNameFromMount(mounter mount.Interface, mountPath, pluginMountDir string) (string, error) {
 	return getDeviceNameFromMount(mounter, mountPath, pluginMountDir)
@@ -583,6 +583,32 @@ func GetPluginMountDir(host volume.VolumeHost, name string) string {
 	return mntDir
 }
 
+// ValidatePathNoSymlinkEscape resolves targetPath's symlinks -- through a
+// containerized kubelet's root remapping, same as host.GetHostUtil() does
+// for every other volume plugin -- and returns an error unless the fully
+// resolved path is still within baseDir. Use it for paths kubelet
+// constructs itself but does not control every component of, such as a
+// CSI NodePublish/NodeStage target directory a compromised driver could
+// have replaced with a symlink; it applies the same containment check
+// subPath already applies to its bind-mount targets via
+// mount.PathWithinBase. If host does not implement volume.KubeletVolumeHost
+// (e.g. it is a control-plane host with no local filesystem), this is a
+// no-op.
+func ValidatePathNoSymlinkEscape(host volume.VolumeHost, targetPath, baseDir string) error {
+	kvh, ok := host.(volume.KubeletVolumeHost)
+	if !ok {
+		return nil
+	}
+	resolved, err := kvh.GetHostUtil().EvalHostSymlinks(targetPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve symlinks in %q: %v", targetPath, err)
+	}
+	if !mount.PathWithinBase(resolved, baseDir) {
+		return fmt.Errorf("path %q (resolved from %q) is outside of base path %q", resolved, targetPath, baseDir)
+	}
+	return nil
+}
+
 // IsLocalEphemeralVolume determines whether the argument is a local ephemeral
 // volume vs. some other type
 // Local means the volume is using storage from the local disk that is managed by kubelet.
@@ -653,7 +679,7 @@ func HasMountRefs(mountPath string, mountRefs []string) bool {
 	return false
 }
 
-//WriteVolumeCache flush disk data given the spcified mount path
+// WriteVolumeCache flush disk data given the spcified mount path
 func WriteVolumeCache(deviceMountPath string, exec utilexec.Interface) error {
 	// If runtime os is windows, execute Write-VolumeCache powershell command on the disk
 	if runtime.GOOS == "windows" {
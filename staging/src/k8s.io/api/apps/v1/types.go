@@ -39,8 +39,9 @@ const (
 
 // StatefulSet represents a set of pods with consistent identities.
 // Identities are defined as:
-//  - Network: A single stable DNS and hostname.
-//  - Storage: As many VolumeClaims as requested.
+//   - Network: A single stable DNS and hostname.
+//   - Storage: As many VolumeClaims as requested.
+//
 // The StatefulSet guarantees that a given network identity will always
 // map to the same storage identity.
 type StatefulSet struct {
@@ -277,6 +278,14 @@ type StatefulSetStatus struct {
 
 type StatefulSetConditionType string
 
+// These are valid conditions of a statefulset.
+const (
+	// StatefulSetConditionPodBackoff indicates that the StatefulSet
+	// controller is backing off recreating one or more Pods whose ordinal
+	// keeps failing, instead of recreating them on every sync.
+	StatefulSetConditionPodBackoff StatefulSetConditionType = "PodBackoff"
+)
+
 // StatefulSetCondition describes the state of a statefulset at a certain point.
 type StatefulSetCondition struct {
 	// Type of statefulset condition.
@@ -232,6 +232,15 @@ type StatefulSetControllerConfiguration struct {
 	// allowed to sync concurrently. Larger number = more responsive statefulsets,
 	// but more CPU (and network) load.
 	ConcurrentStatefulSetSyncs int32
+
+	// podFailureBackoffInitialDuration and podFailureBackoffMaxDuration bound
+	// the exponential backoff the controller applies, per StatefulSet
+	// ordinal, before recreating a Pod that keeps failing. Raise these for
+	// slot-aware workloads that need more time to fail over before accepting
+	// a replacement. If either is zero, the controller's built-in default
+	// (1s initial, 1m max) is used.
+	PodFailureBackoffInitialDuration metav1.Duration
+	PodFailureBackoffMaxDuration     metav1.Duration
 }
 
 // DeprecatedControllerConfiguration contains elements be deprecated.
@@ -84,6 +84,24 @@ type KubeSchedulerConfiguration struct {
 	// the default value (10s) will be used.
 	PodMaxBackoffSeconds *int64 `json:"podMaxBackoffSeconds,omitempty"`
 
+	// BindTimeoutSeconds bounds how long the binding cycle (WaitOnPermit, PreBind,
+	// Bind and PostBind) may run for a single pod before it is aborted and the pod
+	// is returned to the scheduling queue. A value of 0 means no timeout is applied.
+	// If this value is null, the default value (0, no timeout) will be used.
+	BindTimeoutSeconds *int64 `json:"bindTimeoutSeconds,omitempty"`
+
+	// PreBindPluginTimeoutSeconds bounds how long a single PreBind plugin call
+	// may run. A value of 0 means no per-plugin deadline is applied. If this
+	// value is null, the default value (0, no timeout) will be used.
+	PreBindPluginTimeoutSeconds *int64 `json:"preBindPluginTimeoutSeconds,omitempty"`
+
+	// BindingWorkerPoolSize bounds how many binding cycles (WaitOnPermit
+	// through PostBind) may run concurrently, so a burst of pending pods
+	// can't spawn an unbounded number of goroutines blocked on bind-wait.
+	// A value of 0 leaves binding concurrency unbounded. If this value is
+	// null, the default value (0, unbounded) will be used.
+	BindingWorkerPoolSize *int32 `json:"bindingWorkerPoolSize,omitempty"`
+
 	// Profiles are scheduling profiles that kube-scheduler supports. Pods can
 	// choose to be scheduled under a particular profile by setting its associated
 	// scheduler name. Pods that don't specify any scheduler name are scheduled
@@ -202,6 +220,12 @@ type PluginSet struct {
 	Enabled []Plugin `json:"enabled,omitempty"`
 	// Disabled specifies default plugins that should be disabled.
 	// When all default plugins need to be disabled, an array containing only one "*" should be provided.
+	//
+	// Disabling "*" and then listing every plugin that should still run, in
+	// the desired order, in Enabled is also how the relative order of
+	// default plugins can be changed, e.g. to run an expensive Filter
+	// plugin like VolumeBinding after cheaper ones have had a chance to
+	// reject the node.
 	// +listType=map
 	// +listMapKey=name
 	Disabled []Plugin `json:"disabled,omitempty"`
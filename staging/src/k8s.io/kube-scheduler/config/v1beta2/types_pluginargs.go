@@ -158,6 +158,39 @@ type VolumeBindingArgs struct {
 	// +optional
 	// +listType=atomic
 	Shape []UtilizationShapePoint `json:"shape,omitempty"`
+
+	// EnableBatchReservation opts into briefly caching a node's Filter
+	// rejection per owning controller (for example, several StatefulSet
+	// replicas created together) and set of claims, so that a repeated Filter
+	// call for a pod with the same claims against that node reuses the
+	// rejection instead of re-deriving PV candidate sets. Feasible results are
+	// not cached, and volumes are still matched and reserved one pod at a time.
+	// If this value is nil, the default value (false) will be used.
+	// +optional
+	EnableBatchReservation *bool `json:"enableBatchReservation,omitempty"`
+
+	// EnableCrossSchedulerReservation opts into recording provisional PV
+	// reservations as a PV annotation with a TTL, so that other scheduler
+	// instances can see a reservation before the real bind lands and avoid
+	// racing to assume the same PV.
+	// If this value is nil, the default value (false) will be used.
+	// +optional
+	EnableCrossSchedulerReservation *bool `json:"enableCrossSchedulerReservation,omitempty"`
+
+	// EnableCapacityReservationPublishing opts into publishing a ConfigMap
+	// recording pending dynamic-provisioning demand once the binder has made
+	// its API update to trigger provisioning on a node, so that external capacity planners
+	// can observe the demand before the PV actually exists.
+	// If this value is nil, the default value (false) will be used.
+	// +optional
+	EnableCapacityReservationPublishing *bool `json:"enableCapacityReservationPublishing,omitempty"`
+
+	// MaxAttachmentsPerVolume caps how many nodes may simultaneously have a
+	// pod assumed or bound against the same PV. Filter rejects nodes that
+	// would push a shared (for example RWX) PV over this limit.
+	// If this value is nil, the default value (0, no limit) will be used.
+	// +optional
+	MaxAttachmentsPerVolume *int32 `json:"maxAttachmentsPerVolume,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
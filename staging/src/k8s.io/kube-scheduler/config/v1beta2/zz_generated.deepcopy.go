@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -155,6 +156,21 @@ func (in *KubeSchedulerConfiguration) DeepCopyInto(out *KubeSchedulerConfigurati
 		*out = new(int64)
 		**out = **in
 	}
+	if in.BindTimeoutSeconds != nil {
+		in, out := &in.BindTimeoutSeconds, &out.BindTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreBindPluginTimeoutSeconds != nil {
+		in, out := &in.PreBindPluginTimeoutSeconds, &out.PreBindPluginTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BindingWorkerPoolSize != nil {
+		in, out := &in.BindingWorkerPoolSize, &out.BindingWorkerPoolSize
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Profiles != nil {
 		in, out := &in.Profiles, &out.Profiles
 		*out = make([]KubeSchedulerProfile, len(*in))
@@ -513,6 +529,26 @@ func (in *VolumeBindingArgs) DeepCopyInto(out *VolumeBindingArgs) {
 		*out = make([]UtilizationShapePoint, len(*in))
 		copy(*out, *in)
 	}
+	if in.EnableBatchReservation != nil {
+		in, out := &in.EnableBatchReservation, &out.EnableBatchReservation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCrossSchedulerReservation != nil {
+		in, out := &in.EnableCrossSchedulerReservation, &out.EnableCrossSchedulerReservation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableCapacityReservationPublishing != nil {
+		in, out := &in.EnableCapacityReservationPublishing, &out.EnableCapacityReservationPublishing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAttachmentsPerVolume != nil {
+		in, out := &in.MaxAttachmentsPerVolume, &out.MaxAttachmentsPerVolume
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -77,6 +77,12 @@ type ExtenderArgs struct {
 	// List of candidate node names where the pod can be scheduled; to be
 	// populated only if Extender.NodeCacheCapable == true
 	NodeNames *[]string
+	// FailedNodesStatuses carries forward the failure reasons recorded by
+	// in-tree filter plugins (e.g. volume binding conflicts) for nodes that
+	// were already excluded before this extender ran. These nodes are not
+	// present in Nodes/NodeNames, so the extender cannot override them; the
+	// field is informational only, e.g. for logging or metrics.
+	FailedNodesStatuses FailedNodesMap `json:"failedNodesStatuses,omitempty"`
 }
 
 // FailedNodesMap represents the filtered out nodes, with node names and failure messages
@@ -46,6 +46,13 @@ func (in *ExtenderArgs) DeepCopyInto(out *ExtenderArgs) {
 			copy(*out, *in)
 		}
 	}
+	if in.FailedNodesStatuses != nil {
+		in, out := &in.FailedNodesStatuses, &out.FailedNodesStatuses
+		*out = make(FailedNodesMap, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 